@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/metadata"
+)
+
+/* Runs fn against the admin service, attaching the admin token to the request */
+func withAdmin(c *cli.Context, fn func(ctx context.Context, sc hangmanv1.AdminServiceClient) error) error {
+	token := c.String("token")
+	if token == "" {
+		return errors.New("Admin token required - set --token or HANGMAN_ADMIN_TOKEN")
+	}
+
+	cc, err := dial()
+
+	if err != nil {
+		return err
+	}
+
+	defer cc.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	return fn(ctx, hangmanv1.NewAdminServiceClient(cc))
+}
+
+/* Parses the game number argument at position i */
+func gameArg(c *cli.Context, i int) (int32, error) {
+	gn, err := strconv.ParseInt(c.Args().Get(i), 10, 32)
+	if err != nil {
+		return 0, errors.New("Invalid param - game no")
+	}
+	return int32(gn), nil
+}
+
+/* Prints full detail of a game, including its secret word */
+func printAdminGame(g *hangmanv1.AdminGame) {
+	fmt.Printf("   %d	   %s       %t       %d      %s      %s      %s      %s      %s\n",
+		g.GameId,
+		g.Winner,
+		g.Active,
+		g.Turns,
+		g.CompleteWord,
+		g.PlayWord,
+		strings.Join(g.Hits, ","),
+		strings.Join(g.Misses, ","),
+		strings.Join(g.Players, ","),
+	)
+}
+
+/* "admin" command and its subcommands, all calling AdminService on server-side */
+func adminCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "admin",
+		Usage: "Inspect and control the running server (requires admin token)",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "token",
+				Usage:   "admin token configured on the server",
+				EnvVars: []string{"HANGMAN_ADMIN_TOKEN"},
+			},
+		},
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "Print all games including their secret words",
+				Action: func(c *cli.Context) error {
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.ListAllGames(ctx, &hangmanv1.ListAllGamesRequest{})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("\nGAME ID| WINNER | PLAYABLE | TURNS | WORD STATE | WORD | HITS | MISSES | PLAYERS\n")
+						for _, g := range res.Games {
+							printAdminGame(g)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "delete [game number (int)]",
+				Action: func(c *cli.Context) error {
+					gn, err := gameArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.DeleteGame(ctx, &hangmanv1.DeleteGameRequest{GameId: gn})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Game %d deleted\n", res.GameId)
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "end",
+				Usage: "end [game number (int)]",
+				Action: func(c *cli.Context) error {
+					gn, err := gameArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.EndGame(ctx, &hangmanv1.EndGameRequest{GameId: gn})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printAdminGame(res.Game)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "reset",
+				Usage: "reset [game number (int)]",
+				Action: func(c *cli.Context) error {
+					gn, err := gameArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.ResetGame(ctx, &hangmanv1.ResetGameRequest{GameId: gn})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printAdminGame(res.Game)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "kick",
+				Usage: "kick [game number (int)] [username (string)]",
+				Action: func(c *cli.Context) error {
+					gn, err := gameArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					username := c.Args().Get(1)
+					if username == "" {
+						return errors.New("Invalid param - username")
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.KickUser(ctx, &hangmanv1.KickUserRequest{GameId: gn, Username: username})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printAdminGame(res.Game)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "ban",
+				Usage: "ban [username (string)]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "lift",
+						Usage: "lift an existing ban instead",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					username := c.Args().Get(0)
+					if username == "" {
+						return errors.New("Invalid param - username")
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.BanUser(ctx, &hangmanv1.BanUserRequest{Username: username, Unban: c.Bool("lift")})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Banned users: %s\n", strings.Join(res.Banned, ", "))
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "reload-words",
+				Usage: "Reload the server word list",
+				Action: func(c *cli.Context) error {
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.ReloadWords(ctx, &hangmanv1.ReloadWordsRequest{})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Word list reloaded with %d words\n", res.WordCount)
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "turns",
+				Usage: "turns [turn budget for new games (int)]",
+				Action: func(c *cli.Context) error {
+					turns, err := strconv.ParseInt(c.Args().Get(0), 10, 32)
+					if err != nil {
+						return errors.New("Invalid param - turns")
+					}
+
+					return withAdmin(c, func(ctx context.Context, sc hangmanv1.AdminServiceClient) error {
+						res, err := sc.SetDefaultTurns(ctx, &hangmanv1.SetDefaultTurnsRequest{Turns: int32(turns)})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Default turns changed from %d to %d\n", res.PreviousTurns, res.Turns)
+						return nil
+					})
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+/* "bot" command - adds a bot player to a game, calling "/addbot" handler on server-side */
+func botCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "bot",
+		Usage: "bot [game number (int)] [skill: random, frequency or optimal] [optional_username string]",
+		Action: func(c *cli.Context) error {
+			gn, err := gameArg(c, 0)
+			if err != nil {
+				return err
+			}
+
+			skill, ok := hangmanv1.BotSkill_value["BOT_SKILL_"+strings.ToUpper(c.Args().Get(1))]
+			if !ok || skill == 0 {
+				return fmt.Errorf("Invalid param - skill must be random, frequency or optimal")
+			}
+
+			username := c.Args().Get(2)
+			if username == "" {
+				username = "guest"
+			}
+
+			return withGames(func(ctx context.Context, sc hangmanv1.HangmanServiceClient) error {
+				res, err := sc.AddBot(ctx, &hangmanv1.AddBotRequest{GameId: gn, Skill: hangmanv1.BotSkill(skill), Username: username})
+				if err != nil {
+					return err
+				}
+
+				if printResult(res) {
+					return nil
+				}
+
+				fmt.Printf("%s joined Game %d\n", res.Username, res.Game.GameId)
+				return nil
+			})
+		},
+	}
+}
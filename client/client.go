@@ -4,7 +4,19 @@
 // Usage: CLI client interface which allows interaction with running server-side application.
 // "newgame" Generates new game on server.
 // "listgames" Generates list of all currently running games on server.
+// "show" Prints the full state of a single game.
 // "guess" Takes game no., letter guess and optional username for server interaction.
+// "solve" Suggests the best next letter, or with --auto plays a game to completion.
+// "bot" Adds a server-side bot player to a game.
+// "room" Hosts or joins a game by its join code.
+// "play" Interactive session: guess letters and chat with other players in a game.
+// "daily" Plays today's daily puzzle and prints a shareable result.
+// "tournament" Creates, runs and reports on tournaments.
+// "tui" Full-screen client: game browser, board with gallows, on-screen keyboard and live activity feed.
+// "ping" Reports server health, version and round-trip latency.
+// "admin" Token-authenticated subcommands for operating the server.
+// The global --output flag prints any command's results as table (default), json or yaml.
+// The global --addr, --tls and --ca flags choose the server and how to reach it.
 package main
 
 import (
@@ -13,14 +25,80 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"unicode"
 	"context"
+	"time"
 
-	"github.com/urfave/cli"
-	"github.com/hill399/HangmanGo/hangmanpb"
-	"google.golang.org/grpc"
+	"github.com/urfave/cli/v2"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+/* Prints a game as a row of the "listgames" table */
+func printGame(g *hangmanv1.Game) {
+	fmt.Printf("   %d	   %s       %t       %d      %s      %s\n",
+		g.GameId,
+		g.Winner,
+		g.Active,
+		g.Turns,
+		strings.Join(strings.Split(g.WordState, ""), ","),
+		strings.Join(g.Misses, ","),
+	)
+}
+
+/* Rows of the used-letters keyboard */
+var keyboardRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+/* Prints a keyboard marking hits as [x] and misses as -, leaving letters still available as they are */
+func printKeyboard(g *hangmanv1.Game) {
+	used := make(map[string]string)
+	for _, l := range g.Hits {
+		used[l] = "[" + l + "]"
+	}
+	for _, l := range g.Misses {
+		used[l] = " - "
+	}
+
+	for i, row := range keyboardRows {
+		fmt.Print(strings.Repeat(" ", i*2))
+		for _, r := range row {
+			key, ok := used[string(r)]
+			if !ok {
+				key = " " + string(r) + " "
+			}
+			fmt.Print(key)
+		}
+		fmt.Println()
+	}
+}
+
+/* Formats a Unix nanosecond timestamp, or "-" when unset */
+func formatTime(ns int64) string {
+	if ns == 0 {
+		return "-"
+	}
+	return time.Unix(0, ns).Format(time.RFC3339)
+}
+
+/* Prints the full state of a game returned by GetGame */
+func printGameDetail(res *hangmanv1.GetGameResponse) {
+	g := res.Game
+	fmt.Printf("Game:          %d\n", g.GameId)
+	fmt.Printf("Word:          %s\n", strings.Join(strings.Split(g.WordState, ""), " "))
+	fmt.Printf("Playable:      %t\n", g.Active)
+	fmt.Printf("Turns:         %d/%d\n", g.Turns, g.MaxTurns)
+	fmt.Printf("Winner:        %s\n", g.Winner)
+	fmt.Printf("Hits:          %s\n", strings.Join(g.Hits, ","))
+	fmt.Printf("Misses:        %s\n", strings.Join(g.Misses, ","))
+	fmt.Printf("Players:       %s\n", strings.Join(res.Players, ","))
+	fmt.Printf("Created:       %s\n", formatTime(res.CreatedUnixNano))
+	fmt.Printf("Last activity: %s\n", formatTime(res.LastActivityUnixNano))
+	fmt.Printf("Ended:         %s\n", formatTime(res.EndedUnixNano))
+	fmt.Println()
+	printKeyboard(g)
+}
+
 /* Main client function */
 func main() {
 
@@ -30,6 +108,39 @@ func main() {
 	app.Usage = "Client side CLI for hangman application"
 	app.Version = "1.0.0"
 
+	/* Global flags, given before the command */
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Value:   outputTable,
+			Usage:   "print results as table, json or yaml",
+			EnvVars: []string{"HANGMAN_OUTPUT"},
+		},
+		&cli.StringFlag{
+			Name:    "addr",
+			Value:   serverAddr,
+			Usage:   "address of the hangman gRPC server",
+			EnvVars: []string{"HANGMAN_ADDR"},
+		},
+		&cli.BoolFlag{
+			Name:    "tls",
+			Usage:   "connect over TLS, verifying the server against the system roots",
+			EnvVars: []string{"HANGMAN_TLS"},
+		},
+		&cli.StringFlag{
+			Name:    "ca",
+			Usage:   "PEM CA certificate to verify the server with (implies --tls)",
+			EnvVars: []string{"HANGMAN_CA"},
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		if err := setTransport(c.String("addr"), c.Bool("tls"), c.String("ca")); err != nil {
+			return err
+		}
+		return setOutput(c.String("output"))
+	}
+
 	/* Creation of CLI functions and documentation */
 	/* "Action" is effective function call */
 	app.Commands = []*cli.Command{
@@ -37,10 +148,20 @@ func main() {
 			/* Create new game - calls "/newgame" handler on server-side */
 			Name:    "newgame",
 			Aliases: []string{"n"},
-			Usage:   "Query the server to start a new game",
+			Usage:   "Query the server to start a new game, optionally as [username string]",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  "seed",
+					Usage: "choose the word reproducibly; the same seed gives the same word",
+				},
+				&cli.BoolFlag{
+					Name:  "evil",
+					Usage: "play evil hangman, where the server dodges your guesses",
+				},
+			},
 			Action: func(c *cli.Context) error {
 
-				cc, err := grpc.Dial("0.0.0.0:50051", grpc.WithInsecure())
+				cc, err := dial()
 
 				if err != nil {
 					return err
@@ -48,17 +169,26 @@ func main() {
 			
 				defer cc.Close()
 
-				sc := hangmanpb.NewNewGameServiceClient(cc)
+				sc := hangmanv1.NewHangmanServiceClient(cc)
 
-				req := &hangmanpb.NewGameRequest{}
+				req := &hangmanv1.NewGameRequest{Evil: c.Bool("evil"), Username: c.Args().Get(0)}
+
+				if c.IsSet("seed") {
+					seed := c.Int64("seed")
+					req.Seed = &seed
+				}
 
 				res, err := sc.NewGame(context.Background(), req)
 			
 				if err != nil {
 					log.Fatalf("Error while calling New Game rpc: %v", err)
 				}
+
+				if printResult(res) {
+					return nil
+				}
 			
-				log.Printf("Game %v Created", res.GameNumber)
+				log.Printf("Game %v Created", res.GameId)
 
 				return nil
 			},
@@ -70,7 +200,7 @@ func main() {
 			Usage:   "Print list of currently open games",
 			Action: func(c *cli.Context) error {
 
-				cc, err := grpc.Dial("0.0.0.0:50051", grpc.WithInsecure())
+				cc, err := dial()
 
 				if err != nil {
 					return err
@@ -78,17 +208,66 @@ func main() {
 			
 				defer cc.Close()
 
-				sc := hangmanpb.NewListServiceClient(cc)
+				sc := hangmanv1.NewHangmanServiceClient(cc)
 
-				req := &hangmanpb.ListRequest{}
+				req := &hangmanv1.ListRequest{}
 
 				res, err := sc.List(context.Background(), req)
 			
 				if err != nil {
 					log.Fatalf("Error while calling List Game rpc: %v", err)
 				}
+
+				if printResult(res) {
+					return nil
+				}
 			
-				log.Printf("Game Details:\n %v", res.GameDetails)
+				fmt.Printf("\nGAME ID| WINNER | PLAYABLE | TURNS | WORD STATE | MISSES\n")
+				for _, g := range res.Games {
+					printGame(g)
+				}
+
+				return nil
+			},
+		},
+		{
+			/* Show one game - calls "/getgame" handler on server-side */
+			Name:    "show",
+			Aliases: []string{"s"},
+			Usage:   "show [game number (int)] [optional_username string]",
+			Action: func(c *cli.Context) error {
+				gn, err := gameArg(c, 0)
+				if err != nil {
+					return err
+				}
+
+				/* Private rooms are only shown to their players */
+				username := c.Args().Get(1)
+				if username == "" {
+					username = "guest"
+				}
+
+				cc, err := dial()
+
+				if err != nil {
+					return err
+				}
+
+				defer cc.Close()
+
+				sc := hangmanv1.NewHangmanServiceClient(cc)
+
+				res, err := sc.GetGame(context.Background(), &hangmanv1.GetGameRequest{GameId: gn, Username: username})
+
+				if err != nil {
+					log.Fatalf("Error while calling Get Game rpc: %v", err)
+				}
+
+				if printResult(res) {
+					return nil
+				}
+
+				printGameDetail(res)
 
 				return nil
 			},
@@ -122,7 +301,7 @@ func main() {
 					}
 				}
 
-				cc, err := grpc.Dial("0.0.0.0:50051", grpc.WithInsecure())
+				cc, err := dial()
 
 				if err != nil {
 					return err
@@ -130,16 +309,14 @@ func main() {
 			
 				defer cc.Close()
 
-				sc := hangmanpb.NewGuessServiceClient(cc)
+				sc := hangmanv1.NewHangmanServiceClient(cc)
 
 				gn, _ := strconv.Atoi(gameNo)
 
-				req := &hangmanpb.GuessRequest{
-					Guess: &hangmanpb.Guess{
-						GameNumber: int32(gn),
-						GuessLetter: gameGuess,
-						Username: username,
-					},
+				req := &hangmanv1.GuessRequest{
+					GameId:   int32(gn),
+					Letter:   gameGuess,
+					Username: username,
 				}
 
 				res, err := sc.Guess(context.Background(), req)
@@ -147,16 +324,78 @@ func main() {
 				if err != nil {
 					log.Fatalf("Error while calling Guess rpc: %v", err)
 				}
+
+				if printResult(res) {
+					return nil
+				}
 			
-				log.Printf("Guess Response:\n %v", res.Response)
+				log.Printf("Guess Response:")
+				printGame(res.Game)
 				
 				for _, line := range res.Detail {
 					fmt.Println(line)
 				}
 
+				printKeyboard(res.Game)
+
+				return nil
+			},
+		},
+		{
+			/* Check server health and version - calls health and "/ping" handlers on server-side */
+			Name:  "ping",
+			Usage: "Check the server is up, reporting its version and latency",
+			Action: func(c *cli.Context) error {
+
+				cc, err := dial()
+
+				if err != nil {
+					return err
+				}
+
+				defer cc.Close()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				start := time.Now()
+				hres, err := healthpb.NewHealthClient(cc).Check(ctx, &healthpb.HealthCheckRequest{})
+
+				if err != nil {
+					log.Fatalf("Error while calling health check: %v", err)
+				}
+
+				latency := time.Since(start)
+
+				res, err := hangmanv1.NewHangmanServiceClient(cc).Ping(ctx, &hangmanv1.PingRequest{})
+
+				if err != nil {
+					log.Fatalf("Error while calling Ping rpc: %v", err)
+				}
+
+				result := struct {
+					Status        string  `json:"status"`
+					ServerVersion string  `json:"serverVersion"`
+					LatencyMs     float64 `json:"latencyMs"`
+				}{hres.Status.String(), res.ServerVersion, float64(latency.Microseconds()) / 1000}
+
+				if printValue(result) {
+					return nil
+				}
+
+				fmt.Printf("Server %s, version %s, latency %v\n", hres.Status, res.ServerVersion, latency)
+
 				return nil
 			},
 		},
+		solveCommand(),
+		botCommand(),
+		roomCommand(),
+		playCommand(),
+		dailyCommand(),
+		tournamentCommand(),
+		tuiCommand(),
+		adminCommand(),
 	}
 
 	/* Start CLI app */
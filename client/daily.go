@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+/* "daily" command - plays today's puzzle, calling "/getdaily" and "/guessdaily" handlers on server-side */
+func dailyCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "daily",
+		Aliases: []string{"d"},
+		Usage:   "daily [username string] - enter one letter per line, then share the result",
+		Action: func(c *cli.Context) error {
+			username := c.Args().Get(0)
+			if username == "" {
+				username = os.Getenv("USER")
+			}
+
+			cc, err := dial()
+
+			if err != nil {
+				return err
+			}
+
+			defer cc.Close()
+
+			sc := hangmanv1.NewHangmanServiceClient(cc)
+
+			res, err := sc.GetDaily(context.Background(), &hangmanv1.GetDailyRequest{Username: username})
+
+			if err != nil {
+				log.Fatalf("Error while calling Get Daily rpc: %v", err)
+			}
+
+			if !printResult(res) {
+				fmt.Printf("Daily puzzle %s for %s\n", res.Date, username)
+			}
+
+			game, share := res.Game, res.Share
+			scanner := bufio.NewScanner(os.Stdin)
+
+			for game.Active {
+				/* Structured output carries the board in each response, so skip the prompt */
+				if tableOutput() {
+					fmt.Printf("\n%s    %d turns left\n\n", strings.Join(strings.Split(game.WordState, ""), " "), game.Turns)
+					printKeyboard(game)
+					fmt.Print("\nGuess: ")
+				}
+
+				if !scanner.Scan() {
+					if tableOutput() {
+						fmt.Println()
+					}
+					return nil
+				}
+
+				letter := strings.TrimSpace(scanner.Text())
+				if letter == "" {
+					continue
+				}
+
+				gres, err := sc.GuessDaily(context.Background(), &hangmanv1.GuessDailyRequest{Username: username, Letter: letter})
+
+				/* A refused guess, such as one that is not a letter, costs nothing, so ask again */
+				if err != nil {
+					fmt.Fprintln(os.Stderr, "Error:", err)
+					continue
+				}
+
+				game, share = gres.Game, gres.Share
+
+				if printResult(gres) {
+					continue
+				}
+
+				for _, line := range gres.Detail {
+					fmt.Print(line)
+				}
+			}
+
+			if !tableOutput() {
+				return nil
+			}
+
+			fmt.Printf("\n%s\n\n%s\n", strings.Join(strings.Split(game.WordState, ""), " "), share)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+/* Server address and transport chosen with the global --addr, --tls and --ca flags */
+var (
+	serverAddr = "0.0.0.0:50051"
+	dialCreds  = insecure.NewCredentials()
+)
+
+/* Selects how commands reach the server. A CA file implies TLS; --tls alone */
+/* verifies the server against the system roots */
+func setTransport(addr string, useTLS bool, ca string) error {
+	serverAddr = addr
+
+	switch {
+	case ca != "":
+		creds, err := credentials.NewClientTLSFromFile(ca, "")
+		if err != nil {
+			return fmt.Errorf("Invalid --ca %q: %v", ca, err)
+		}
+		dialCreds = creds
+	case useTLS:
+		dialCreds = credentials.NewTLS(&tls.Config{})
+	}
+	return nil
+}
+
+/* Connects to the server chosen with the global flags */
+func dial() (*grpc.ClientConn, error) {
+	return grpc.Dial(serverAddr, grpc.WithTransportCredentials(dialCreds))
+}
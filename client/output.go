@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+/* Formats selectable with the global --output flag */
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var (
+	outputMux    sync.Mutex
+	outputFormat = outputTable
+	/* Documents printed so far, so YAML documents after the first are separated */
+	outputCount int
+)
+
+func setOutput(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		outputFormat = format
+		return nil
+	}
+	return fmt.Errorf("Invalid --output %q - use table, json or yaml", format)
+}
+
+/* Reports whether results are printed for people rather than scripts */
+func tableOutput() bool {
+	return outputFormat == outputTable
+}
+
+/* Prints a response as JSON or YAML when selected, reporting whether it did. */
+/* With table output nothing is printed and the caller prints it for people. */
+/* Field names match the REST API */
+func printResult(m proto.Message) bool {
+	if tableOutput() {
+		return false
+	}
+
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error while encoding output: %v\n", err)
+		return true
+	}
+	writeResult(b)
+	return true
+}
+
+/* As printResult, for results which are not protobuf messages */
+func printValue(v interface{}) bool {
+	if tableOutput() {
+		return false
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error while encoding output: %v\n", err)
+		return true
+	}
+	writeResult(b)
+	return true
+}
+
+/* Writes one JSON document to stdout in the selected format */
+func writeResult(js []byte) {
+	outputMux.Lock()
+	defer outputMux.Unlock()
+
+	var out bytes.Buffer
+	switch outputFormat {
+	case outputJSON:
+		json.Indent(&out, js, "", "  ")
+		out.WriteByte('\n')
+	case outputYAML:
+		/* Decode into a node rather than a map to keep fields in order */
+		var node yaml.Node
+		if err := yaml.Unmarshal(js, &node); err != nil {
+			fmt.Fprintf(os.Stderr, "Error while encoding output: %v\n", err)
+			return
+		}
+		blockStyle(&node)
+
+		if outputCount > 0 {
+			out.WriteString("---\n")
+		}
+		enc := yaml.NewEncoder(&out)
+		enc.SetIndent(2)
+		enc.Encode(&node)
+		enc.Close()
+	}
+
+	outputCount++
+	os.Stdout.Write(out.Bytes())
+}
+
+/* Clears the JSON flow style and quoting from a decoded document so it prints as plain YAML */
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+/* Prints a chat message or reaction received on the GameChat stream */
+func printChat(m *hangmanv1.ChatMessage) {
+	sent := time.Unix(0, m.SentUnixNano).Format("15:04:05")
+	if m.Reaction != "" {
+		fmt.Printf("[chat %s] %s reacted %s\n", sent, m.Username, m.Reaction)
+		return
+	}
+	fmt.Printf("[chat %s] %s: %s\n", sent, m.Username, m.Text)
+}
+
+/* "play" command - interactive session combining guesses with the game's chat pane */
+func playCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "play",
+		Aliases: []string{"p"},
+		Usage:   "play [game number (int)] [optional_username string] - single letters guess, other lines chat, /react <emoji>, /quit",
+		Action: func(c *cli.Context) error {
+			gn, err := gameArg(c, 0)
+			if err != nil {
+				return err
+			}
+
+			username := c.Args().Get(1)
+			if username == "" {
+				username = "guest"
+			}
+
+			cc, err := dial()
+
+			if err != nil {
+				return err
+			}
+
+			defer cc.Close()
+
+			sc := hangmanv1.NewHangmanServiceClient(cc)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream, err := sc.GameChat(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&hangmanv1.GameChatRequest{GameId: gn, Username: username}); err != nil {
+				return err
+			}
+
+			/* Chat pane - prints incoming messages until the stream ends */
+			chatDone := make(chan error, 1)
+			go func() {
+				for {
+					res, err := stream.Recv()
+					if err != nil {
+						chatDone <- err
+						return
+					}
+					if !printResult(res.Message) {
+						printChat(res.Message)
+					}
+				}
+			}()
+
+			if res, err := sc.GetGame(ctx, &hangmanv1.GetGameRequest{GameId: gn, Username: username}); err == nil && !printResult(res) {
+				printGame(res.Game)
+				printKeyboard(res.Game)
+			}
+
+			lines := make(chan string)
+			go func() {
+				scanner := bufio.NewScanner(os.Stdin)
+				for scanner.Scan() {
+					lines <- scanner.Text()
+				}
+				close(lines)
+			}()
+
+			for {
+				select {
+				case err := <-chatDone:
+					if err == io.EOF {
+						return nil
+					}
+					return fmt.Errorf("Chat closed: %v", err)
+				case line, ok := <-lines:
+					if !ok || line == "/quit" {
+						/* Let the server end the stream cleanly before hanging up */
+						stream.CloseSend()
+						select {
+						case <-chatDone:
+						case <-time.After(time.Second):
+						}
+						return nil
+					}
+
+					line = strings.TrimSpace(line)
+					switch {
+					case line == "":
+						continue
+					case strings.HasPrefix(line, "/react "):
+						err = stream.Send(&hangmanv1.GameChatRequest{Reaction: strings.TrimSpace(strings.TrimPrefix(line, "/react "))})
+					case utf8.RuneCountInString(line) == 1:
+						var res *hangmanv1.GuessResponse
+						res, err = sc.Guess(ctx, &hangmanv1.GuessRequest{GameId: gn, Letter: line, Username: username})
+						if err == nil && !printResult(res) {
+							printGame(res.Game)
+							for _, l := range res.Detail {
+								fmt.Println(l)
+							}
+							printKeyboard(res.Game)
+						}
+					default:
+						err = stream.Send(&hangmanv1.GameChatRequest{Text: line})
+					}
+
+					if err != nil {
+						fmt.Println("Error:", err)
+					}
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+/* Runs fn against the game service */
+func withGames(fn func(ctx context.Context, sc hangmanv1.HangmanServiceClient) error) error {
+	cc, err := dial()
+
+	if err != nil {
+		return err
+	}
+
+	defer cc.Close()
+
+	return fn(context.Background(), hangmanv1.NewHangmanServiceClient(cc))
+}
+
+/* "room" command - hosts and joins games by code, calling "/createroom" and "/joinroom" handlers on server-side */
+func roomCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "room",
+		Aliases: []string{"r"},
+		Usage:   "Host or join a game by its join code",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "create [--password p] [--max-players n] [--private] [host username]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "password",
+						Usage: "password players must give to join",
+					},
+					&cli.IntFlag{
+						Name:  "max-players",
+						Usage: "limit on players, host included (0 for no limit)",
+					},
+					&cli.BoolFlag{
+						Name:  "private",
+						Usage: "leave the room out of the public game list",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					req := &hangmanv1.CreateRoomRequest{
+						Host:       c.Args().First(),
+						Password:   c.String("password"),
+						MaxPlayers: int32(c.Int("max-players")),
+						Private:    c.Bool("private"),
+					}
+
+					return withGames(func(ctx context.Context, sc hangmanv1.HangmanServiceClient) error {
+						res, err := sc.CreateRoom(ctx, req)
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Game %d created, join code %s\n", res.GameId, res.Code)
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "join",
+				Usage: "join [--password p] [join code string] [username string]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "password",
+						Usage: "room password, if it has one",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					req := &hangmanv1.JoinRoomRequest{
+						Code:     c.Args().Get(0),
+						Username: c.Args().Get(1),
+						Password: c.String("password"),
+					}
+
+					return withGames(func(ctx context.Context, sc hangmanv1.HangmanServiceClient) error {
+						res, err := sc.JoinRoom(ctx, req)
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("Joined Game %d\n", res.Game.GameId)
+						printGame(res.Game)
+						return nil
+					})
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Number of ranked letters printed for a hint */
+const hintsShown = 5
+
+/* Prints the solver's best letters for a game */
+func printHint(res *hangmanv1.SuggestLetterResponse) {
+	fmt.Printf("Suggest %s (%d candidate words", res.Letter, res.Candidates)
+	if res.TurnsCost > 0 {
+		fmt.Printf(", cost %d turns", res.TurnsCost)
+	}
+	fmt.Printf(")\n")
+
+	for i, s := range res.Ranked {
+		if i == hintsShown {
+			break
+		}
+		fmt.Printf("   %s   %5.1f%%   %.2f bits\n", s.Letter, s.Probability*100, s.Entropy)
+	}
+}
+
+/* Picks the best letter from an earlier ranking which game has not played yet, */
+/* or "" when every ranked letter has been played */
+func nextRanked(ranked []*hangmanv1.LetterScore, game *hangmanv1.Game) string {
+	played := make(map[string]bool)
+	for _, l := range append(append([]string(nil), game.Hits...), game.Misses...) {
+		played[l] = true
+	}
+
+	for _, s := range ranked {
+		if !played[s.Letter] {
+			return s.Letter
+		}
+	}
+	return ""
+}
+
+/* "solve" command - asks the solver for hints, calling "/suggestletter" handler on server-side */
+func solveCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "solve",
+		Usage: "solve [--auto] [game number (int)] [optional_username string]",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "auto",
+				Usage: "keep guessing the suggested letter until the game is over",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			gn, err := gameArg(c, 0)
+			if err != nil {
+				return err
+			}
+
+			username := c.Args().Get(1)
+			if username == "" {
+				username = "solver"
+			}
+
+			return withGames(func(ctx context.Context, sc hangmanv1.HangmanServiceClient) error {
+				var ranked []*hangmanv1.LetterScore
+				var game *hangmanv1.Game
+
+				for {
+					var letter string
+
+					hint, err := sc.SuggestLetter(ctx, &hangmanv1.SuggestLetterRequest{GameId: gn, Username: username})
+					switch {
+					case err == nil:
+						if !printResult(hint) {
+							printHint(hint)
+						}
+						letter, ranked = hint.Letter, hint.Ranked
+
+					/* The server refuses hints once the game can't afford them, so carry */
+					/* on down the last ranking it gave, and stop when that runs out */
+					case c.Bool("auto") && status.Code(err) == codes.FailedPrecondition && game != nil:
+						letter = nextRanked(ranked, game)
+						if letter == "" {
+							fmt.Fprintf(os.Stderr, "Stopping: %s\n", status.Convert(err).Message())
+							return nil
+						}
+						fmt.Fprintf(os.Stderr, "No hint (%s), guessing %s from the last ranking\n", status.Convert(err).Message(), letter)
+
+					default:
+						return err
+					}
+
+					if !c.Bool("auto") {
+						return nil
+					}
+
+					res, err := sc.Guess(ctx, &hangmanv1.GuessRequest{GameId: gn, Letter: letter, Username: username})
+					if err != nil {
+						return err
+					}
+					game = res.Game
+
+					if !printResult(res) {
+						printGame(res.Game)
+						for _, line := range res.Detail {
+							fmt.Print(line)
+						}
+						fmt.Println()
+					}
+
+					if !res.Game.Active {
+						return nil
+					}
+				}
+			})
+		},
+	}
+}
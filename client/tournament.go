@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+/* Runs fn against the tournament service */
+func withTournaments(fn func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error) error {
+	cc, err := dial()
+
+	if err != nil {
+		return err
+	}
+
+	defer cc.Close()
+
+	return fn(context.Background(), hangmanv1.NewTournamentServiceClient(cc))
+}
+
+/* Parses the tournament number argument at position i */
+func tournamentArg(c *cli.Context, i int) (int32, error) {
+	tn, err := strconv.ParseInt(c.Args().Get(i), 10, 32)
+	if err != nil {
+		return 0, errors.New("Invalid param - tournament no")
+	}
+	return int32(tn), nil
+}
+
+/* Prints a tournament followed by its matches, round by round */
+func printTournament(t *hangmanv1.Tournament) {
+	format := "round robin"
+	if t.Format == hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_KNOCKOUT {
+		format = "knockout"
+	}
+
+	fmt.Printf("Tournament %d: %s (%s)\n", t.TournamentId, t.Name, format)
+	fmt.Printf("Players: %s\n", strings.Join(t.Players, ","))
+
+	switch {
+	case t.Finished:
+		fmt.Printf("Finished, champion %s\n", t.Champion)
+	case t.CurrentRound == 0:
+		fmt.Printf("Open for registration\n")
+	default:
+		fmt.Printf("Round %d of %d\n", t.CurrentRound, t.TotalRounds)
+	}
+
+	if len(t.Matches) > 0 {
+		fmt.Printf("\nROUND | GAME ID | PLAYERS | RESULT\n")
+	}
+	for _, m := range t.Matches {
+		result := "playing"
+		switch {
+		case len(m.Players) == 1:
+			result = "bye"
+		case m.Finished && m.Winner == "":
+			result = "draw"
+		case m.Finished:
+			result = m.Winner + " won"
+		case m.GameId < 0:
+			result = "to play"
+		}
+
+		game := "-"
+		if m.GameId >= 0 {
+			game = strconv.Itoa(int(m.GameId))
+		}
+
+		fmt.Printf("   %d       %s      %s      %s\n", m.Round, game, strings.Join(m.Players, " v "), result)
+	}
+}
+
+/* "tournament" command and its subcommands, all calling TournamentService on server-side */
+func tournamentCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "tournament",
+		Aliases: []string{"t"},
+		Usage:   "Run round-robin or knockout tournaments",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "create [--knockout] [--seed n] [name string] [players string...]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "knockout",
+						Usage: "play a knockout bracket instead of a round robin",
+					},
+					&cli.Int64Flag{
+						Name:  "seed",
+						Usage: "choose the tournament's words reproducibly",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					req := &hangmanv1.CreateTournamentRequest{
+						Name:    c.Args().First(),
+						Format:  hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN,
+						Players: c.Args().Tail(),
+					}
+					if c.Bool("knockout") {
+						req.Format = hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_KNOCKOUT
+					}
+					if c.IsSet("seed") {
+						seed := c.Int64("seed")
+						req.Seed = &seed
+					}
+
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.CreateTournament(ctx, req)
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printTournament(res.Tournament)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "register",
+				Usage: "register [tournament number (int)] [username string]",
+				Action: func(c *cli.Context) error {
+					tn, err := tournamentArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.RegisterPlayer(ctx, &hangmanv1.RegisterPlayerRequest{TournamentId: tn, Username: c.Args().Get(1)})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printTournament(res.Tournament)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "start",
+				Usage: "start [tournament number (int)]",
+				Action: func(c *cli.Context) error {
+					tn, err := tournamentArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.StartTournament(ctx, &hangmanv1.StartTournamentRequest{TournamentId: tn})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printTournament(res.Tournament)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "show",
+				Usage: "show [tournament number (int)]",
+				Action: func(c *cli.Context) error {
+					tn, err := tournamentArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.GetTournament(ctx, &hangmanv1.GetTournamentRequest{TournamentId: tn})
+						if err != nil {
+							return err
+						}
+
+						if !printResult(res) {
+							printTournament(res.Tournament)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "Print all tournaments",
+				Action: func(c *cli.Context) error {
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.ListTournaments(ctx, &hangmanv1.ListTournamentsRequest{})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						for _, t := range res.Tournaments {
+							printTournament(t)
+							fmt.Println()
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "standings",
+				Usage: "standings [tournament number (int)]",
+				Action: func(c *cli.Context) error {
+					tn, err := tournamentArg(c, 0)
+					if err != nil {
+						return err
+					}
+
+					return withTournaments(func(ctx context.Context, sc hangmanv1.TournamentServiceClient) error {
+						res, err := sc.GetStandings(ctx, &hangmanv1.GetStandingsRequest{TournamentId: tn})
+						if err != nil {
+							return err
+						}
+
+						if printResult(res) {
+							return nil
+						}
+
+						fmt.Printf("\nPLAYER | PLAYED | WON | DRAWN | LOST | OUT\n")
+						for _, st := range res.Standings {
+							fmt.Printf("   %s       %d       %d       %d       %d      %t\n", st.Username, st.Played, st.Wins, st.Draws, st.Losses, st.Eliminated)
+						}
+						return nil
+					})
+				},
+			},
+		},
+	}
+}
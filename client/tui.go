@@ -0,0 +1,592 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	/* How often the game browser is refreshed from List */
+	tuiRefresh = time.Second
+	/* Lines of activity kept in the feed */
+	tuiFeedLen    = 200
+	tuiBrowserW   = 38
+	tuiMinFeedH   = 4
+	tuiTopPaneH   = 14
+	tuiNoGame     = -1
+	focusBrowser  = 0
+	focusBoard    = 1
+	gallowsStages = 6
+)
+
+/* Gallows drawn after each stage of the figure is lost, from empty to hanged */
+var gallows = [gallowsStages + 1][]string{
+	{"  +---+", "  |   |", "      |", "      |", "      |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", "      |", "      |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", "  |   |", "      |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", " /|   |", "      |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", " /|\\  |", "      |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", " /|\\  |", " /    |", "      |", "========="},
+	{"  +---+", "  |   |", "  O   |", " /|\\  |", " / \\  |", "      |", "========="},
+}
+
+var (
+	paneStyle    = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	focusedStyle = paneStyle.BorderForeground(lipgloss.Color("12"))
+	titleStyle   = lipgloss.NewStyle().Bold(true)
+	selStyle     = lipgloss.NewStyle().Reverse(true)
+	hitStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Bold(true)
+	missStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Faint(true)
+	helpStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+/* Messages delivered to the TUI by background calls */
+type (
+	tickMsg time.Time
+	listMsg struct {
+		games []*hangmanv1.Game
+		err   error
+	}
+	guessMsg struct {
+		res *hangmanv1.GuessResponse
+		err error
+	}
+	newGameMsg struct {
+		id  int32
+		err error
+	}
+	chatMsg struct {
+		gameID int32
+		msg    *hangmanv1.ChatMessage
+	}
+	chatEndMsg struct {
+		gameID int32
+		err    error
+	}
+)
+
+/* State of the full-screen client */
+type tuiModel struct {
+	sc       hangmanv1.HangmanServiceClient
+	username string
+
+	width, height int
+	focus         int
+
+	/* Game browser, fed by List */
+	games    []*hangmanv1.Game
+	selected int
+	loaded   bool
+
+	/* Game open on the board, and the on-screen key under the cursor */
+	gameID         int32
+	keyRow, keyCol int
+
+	/* Chat stream of the open game */
+	chat       chan tea.Msg
+	chatCancel context.CancelFunc
+
+	feed []string
+}
+
+func newTUIModel(sc hangmanv1.HangmanServiceClient, username string) *tuiModel {
+	return &tuiModel{sc: sc, username: username, gameID: tuiNoGame}
+}
+
+func (m *tuiModel) Init() tea.Cmd {
+	return tea.Batch(m.list(), tick())
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(tuiRefresh, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func (m *tuiModel) list() tea.Cmd {
+	return func() tea.Msg {
+		res, err := m.sc.List(context.Background(), &hangmanv1.ListRequest{})
+		if err != nil {
+			return listMsg{err: err}
+		}
+		return listMsg{games: res.Games}
+	}
+}
+
+func (m *tuiModel) guess(letter string) tea.Cmd {
+	req := &hangmanv1.GuessRequest{GameId: m.gameID, Letter: letter, Username: m.username}
+	return func() tea.Msg {
+		res, err := m.sc.Guess(context.Background(), req)
+		return guessMsg{res: res, err: err}
+	}
+}
+
+func (m *tuiModel) newGame() tea.Cmd {
+	return func() tea.Msg {
+		res, err := m.sc.NewGame(context.Background(), &hangmanv1.NewGameRequest{Username: m.username})
+		if err != nil {
+			return newGameMsg{err: err}
+		}
+		return newGameMsg{id: res.GameId}
+	}
+}
+
+/* Waits for the next message on the open game's chat stream */
+func waitChat(ch chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
+
+/* Joins the chat of the game opened on the board, leaving any previous one */
+func (m *tuiModel) openChat(gameID int32) tea.Cmd {
+	if m.chatCancel != nil {
+		m.chatCancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.chatCancel = cancel
+	ch := make(chan tea.Msg, 16)
+	m.chat = ch
+
+	/* Closing the channel on the way out releases any waitChat left on it */
+	go func() {
+		defer close(ch)
+
+		stream, err := m.sc.GameChat(ctx)
+		if err == nil {
+			err = stream.Send(&hangmanv1.GameChatRequest{GameId: gameID, Username: m.username})
+		}
+		for err == nil {
+			var res *hangmanv1.GameChatResponse
+			if res, err = stream.Recv(); err == nil {
+				select {
+				case ch <- chatMsg{gameID: gameID, msg: res.Message}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		if ctx.Err() == nil {
+			ch <- chatEndMsg{gameID: gameID, err: err}
+		}
+	}()
+
+	return waitChat(ch)
+}
+
+func (m *tuiModel) log(format string, args ...interface{}) {
+	line := time.Now().Format("15:04:05 ") + fmt.Sprintf(format, args...)
+	m.feed = append(m.feed, line)
+	if len(m.feed) > tuiFeedLen {
+		m.feed = m.feed[len(m.feed)-tuiFeedLen:]
+	}
+}
+
+/* Finds a game in the browser by ID */
+func (m *tuiModel) game(id int32) *hangmanv1.Game {
+	for _, g := range m.games {
+		if g.GameId == id {
+			return g
+		}
+	}
+	return nil
+}
+
+/* Reports to the feed what changed in each game since the last refresh */
+func (m *tuiModel) diff(games []*hangmanv1.Game) {
+	if !m.loaded {
+		return
+	}
+
+	seen := make(map[int32]bool)
+	for _, g := range games {
+		seen[g.GameId] = true
+
+		old := m.game(g.GameId)
+		if old == nil {
+			m.log("Game %d created", g.GameId)
+			continue
+		}
+		/* A reset game starts again with fewer letters played */
+		if len(g.Hits) >= len(old.Hits) && len(g.Misses) >= len(old.Misses) {
+			for _, l := range g.Hits[len(old.Hits):] {
+				m.log("Game %d: %s revealed", g.GameId, l)
+			}
+			for _, l := range g.Misses[len(old.Misses):] {
+				m.log("Game %d: %s missed, %d turns left", g.GameId, l, g.Turns)
+			}
+		}
+		if old.Active && !g.Active {
+			if g.Winner != "" && g.Winner != "N/A" {
+				m.log("Game %d won by %s", g.GameId, g.Winner)
+			} else {
+				m.log("Game %d lost", g.GameId)
+			}
+		}
+	}
+
+	for _, g := range m.games {
+		if !seen[g.GameId] {
+			m.log("Game %d archived", g.GameId)
+		}
+	}
+}
+
+func (m *tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case tickMsg:
+		return m, tea.Batch(m.list(), tick())
+
+	case listMsg:
+		if msg.err != nil {
+			m.log("List failed: %v", msg.err)
+			return m, nil
+		}
+		sort.Slice(msg.games, func(i, j int) bool { return msg.games[i].GameId < msg.games[j].GameId })
+		m.diff(msg.games)
+		m.games, m.loaded = msg.games, true
+		if m.selected >= len(m.games) {
+			m.selected = len(m.games) - 1
+		}
+		if m.selected < 0 {
+			m.selected = 0
+		}
+
+	case guessMsg:
+		if msg.err != nil {
+			m.log("Guess failed: %v", msg.err)
+			return m, nil
+		}
+		for _, line := range msg.res.Detail {
+			m.log("Game %d: %s", msg.res.Game.GameId, strings.TrimSpace(line))
+		}
+		/* Show the result now rather than on the next refresh, without it reaching the feed twice */
+		for i, g := range m.games {
+			if g.GameId == msg.res.Game.GameId {
+				m.games[i] = msg.res.Game
+			}
+		}
+
+	case newGameMsg:
+		if msg.err != nil {
+			m.log("New game failed: %v", msg.err)
+			return m, nil
+		}
+		m.log("Opened Game %d", msg.id)
+		m.gameID, m.focus = msg.id, focusBoard
+		return m, tea.Batch(m.list(), m.openChat(msg.id))
+
+	case chatMsg:
+		/* Drop messages from the chat of a game since left */
+		if msg.gameID != m.gameID {
+			return m, nil
+		}
+		if msg.msg.Reaction != "" {
+			m.log("[chat] %s reacted %s", msg.msg.Username, msg.msg.Reaction)
+		} else {
+			m.log("[chat] %s: %s", msg.msg.Username, msg.msg.Text)
+		}
+		return m, waitChat(m.chat)
+
+	case chatEndMsg:
+		if msg.gameID == m.gameID && msg.err != nil {
+			m.log("Chat for Game %d closed: %v", msg.gameID, msg.err)
+		}
+
+	case tea.KeyMsg:
+		return m.key(msg)
+	}
+
+	return m, nil
+}
+
+/* Handles a key press in the focused pane */
+func (m *tuiModel) key(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	k := msg.String()
+
+	switch k {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "tab":
+		if m.gameID != tuiNoGame {
+			m.focus = 1 - m.focus
+		}
+		return m, nil
+	}
+
+	if m.focus == focusBrowser {
+		switch k {
+		case "q":
+			return m, tea.Quit
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "down", "j":
+			if m.selected < len(m.games)-1 {
+				m.selected++
+			}
+		case "n":
+			return m, m.newGame()
+		case "r":
+			return m, m.list()
+		case "enter":
+			if m.selected < len(m.games) {
+				id := m.games[m.selected].GameId
+				m.focus = focusBoard
+				if id != m.gameID {
+					m.gameID = id
+					m.log("Opened Game %d", id)
+					return m, m.openChat(id)
+				}
+			}
+		}
+		return m, nil
+	}
+
+	/* Board: letters guess directly, arrows move over the on-screen keyboard */
+	switch k {
+	case "esc":
+		m.focus = focusBrowser
+	case "up":
+		if m.keyRow > 0 {
+			m.keyRow--
+		}
+	case "down":
+		if m.keyRow < len(keyboardRows)-1 {
+			m.keyRow++
+		}
+	case "left":
+		if m.keyCol > 0 {
+			m.keyCol--
+		}
+	case "right":
+		m.keyCol++
+	case "enter", " ":
+		return m, m.guess(string(keyboardRows[m.keyRow][m.keyCol]))
+	default:
+		if len(k) == 1 && k[0] >= 'a' && k[0] <= 'z' {
+			return m, m.guess(k)
+		}
+	}
+
+	if max := len(keyboardRows[m.keyRow]) - 1; m.keyCol > max {
+		m.keyCol = max
+	}
+	return m, nil
+}
+
+/* Renders the game browser pane */
+func (m *tuiModel) browserView(h int) string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Games") + "\n")
+	b.WriteString(" ID  WORD          TURNS  STATE\n")
+
+	if len(m.games) == 0 {
+		b.WriteString("\n No games yet - press n\n")
+	}
+
+	/* Scroll so the selection stays in view */
+	rows := h - 2
+	first := 0
+	if m.selected >= rows {
+		first = m.selected - rows + 1
+	}
+
+	for i := first; i < len(m.games) && i < first+rows; i++ {
+		g := m.games[i]
+		state := "open"
+		switch {
+		case !g.Active && g.Winner != "" && g.Winner != "N/A":
+			state = "won"
+		case !g.Active:
+			state = "lost"
+		case g.Room:
+			state = "room"
+		}
+
+		word := g.WordState
+		if len(word) > 12 {
+			word = word[:11] + "…"
+		}
+
+		line := fmt.Sprintf("%3d  %-12s  %2d/%-2d  %s", g.GameId, word, g.Turns, g.MaxTurns, state)
+		if i == m.selected {
+			line = selStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	style := paneStyle
+	if m.focus == focusBrowser {
+		style = focusedStyle
+	}
+	return style.Width(tuiBrowserW).Height(h).Render(strings.TrimRight(b.String(), "\n"))
+}
+
+/* Renders the board pane: gallows, word, status and on-screen keyboard */
+func (m *tuiModel) boardView(w, h int) string {
+	var b strings.Builder
+
+	g := m.game(m.gameID)
+	switch {
+	case m.gameID == tuiNoGame:
+		b.WriteString(titleStyle.Render("Board") + "\n\nSelect a game and press enter")
+	case g == nil:
+		b.WriteString(titleStyle.Render(fmt.Sprintf("Game %d", m.gameID)) + "\n\nGame no longer listed")
+	default:
+		b.WriteString(titleStyle.Render(fmt.Sprintf("Game %d", g.GameId)))
+		if g.Evil {
+			b.WriteString("  (evil)")
+		}
+		b.WriteString("\n\n")
+
+		/* Draw the figure in proportion to the turns used */
+		stage := 0
+		if g.MaxTurns > 0 {
+			used := int(g.MaxTurns - g.Turns)
+			stage = (used*gallowsStages + int(g.MaxTurns) - 1) / int(g.MaxTurns)
+		}
+		if stage > gallowsStages {
+			stage = gallowsStages
+		}
+
+		status := []string{
+			"",
+			strings.Join(strings.Split(g.WordState, ""), " "),
+			"",
+			fmt.Sprintf("Turns %d/%d", g.Turns, g.MaxTurns),
+		}
+		switch {
+		case g.Active:
+			status = append(status, "Playing as "+m.username)
+		case g.Winner != "" && g.Winner != "N/A":
+			status = append(status, hitStyle.Render("Won by "+g.Winner))
+		default:
+			status = append(status, missStyle.Render("Lost"))
+		}
+
+		art := lipgloss.JoinVertical(lipgloss.Left, gallows[stage]...)
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, art, "    ", strings.Join(status, "\n")))
+		b.WriteString("\n\n" + m.keyboardView(g))
+	}
+
+	style := paneStyle
+	if m.focus == focusBoard {
+		style = focusedStyle
+	}
+	return style.Width(w).Height(h).Render(b.String())
+}
+
+/* Renders the on-screen keyboard, marking hits, misses and the cursor */
+func (m *tuiModel) keyboardView(g *hangmanv1.Game) string {
+	/* Only single letters have a key; anything else the server recorded is skipped */
+	used := make(map[byte]lipgloss.Style)
+	for _, l := range g.Hits {
+		if len(l) == 1 {
+			used[l[0]] = hitStyle
+		}
+	}
+	for _, l := range g.Misses {
+		if len(l) == 1 {
+			used[l[0]] = missStyle
+		}
+	}
+
+	var rows []string
+	for i, row := range keyboardRows {
+		var keys []string
+		for j := 0; j < len(row); j++ {
+			key := " " + string(row[j]) + " "
+			if s, ok := used[row[j]]; ok {
+				key = s.Render(key)
+			}
+			if m.focus == focusBoard && i == m.keyRow && j == m.keyCol {
+				key = selStyle.Render(key)
+			}
+			keys = append(keys, key)
+		}
+		rows = append(rows, strings.Repeat(" ", i*2)+strings.Join(keys, ""))
+	}
+	return strings.Join(rows, "\n")
+}
+
+/* Renders the newest lines of the activity feed which fit */
+func (m *tuiModel) feedView(w, h int) string {
+	lines := m.feed
+	if rows := h - 1; len(lines) > rows {
+		lines = lines[len(lines)-rows:]
+	}
+	body := titleStyle.Render("Activity") + "\n" + strings.Join(lines, "\n")
+	return paneStyle.Width(w).Height(h).Render(body)
+}
+
+func (m *tuiModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	/* Borders add two columns and rows around each pane */
+	boardW := m.width - tuiBrowserW - 4
+	if boardW < 40 {
+		boardW = 40
+	}
+	feedH := m.height - tuiTopPaneH - 5
+	if feedH < tuiMinFeedH {
+		feedH = tuiMinFeedH
+	}
+
+	top := lipgloss.JoinHorizontal(lipgloss.Top, m.browserView(tuiTopPaneH), m.boardView(boardW, tuiTopPaneH))
+	feed := m.feedView(m.width-2, feedH)
+
+	help := "↑/↓ select  enter open  n new game  r refresh  tab board  q quit"
+	if m.focus == focusBoard {
+		help = "a-z guess  arrows+enter on-screen keyboard  esc/tab games  ctrl+c quit"
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, top, feed, helpStyle.Render(help))
+}
+
+/* "tui" command - full-screen client with game browser, board and activity feed */
+func tuiCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "tui",
+		Usage: "tui [optional_username string] - full-screen client, browse games and play with the keyboard",
+		Action: func(c *cli.Context) error {
+			if !tableOutput() {
+				return errors.New("The tui command is interactive and does not support --output")
+			}
+
+			username := c.Args().Get(0)
+			if username == "" {
+				username = "guest"
+			}
+
+			cc, err := dial()
+
+			if err != nil {
+				return err
+			}
+
+			defer cc.Close()
+
+			m := newTUIModel(hangmanv1.NewHangmanServiceClient(cc), username)
+			_, err = tea.NewProgram(m, tea.WithAltScreen()).Run()
+			if m.chatCancel != nil {
+				m.chatCancel()
+			}
+			return err
+		},
+	}
+}
@@ -0,0 +1,332 @@
+// Hangman load-testing harness
+// Author: hill399
+
+// Usage: Spins up simulated players against a running server, each playing full
+// games through the generated gRPC clients with a chosen guessing strategy, then
+// reports throughput, latency percentiles and error counts.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+/* Letters by frequency in English text */
+const letterFrequency = "etaoinshrdlcumwfgypbvkjxqz"
+
+/* Bounds on how long a player backs off before retrying a rate limited call */
+const (
+	minBackoff = 50 * time.Millisecond
+	maxBackoff = 2 * time.Second
+)
+
+/* Strategies a simulated player can guess with */
+var strategies = map[string]bool{"random": true, "frequency": true, "solver": true, "mixed": true}
+
+/* Latencies and errors observed for one RPC method */
+type methodStats struct {
+	latencies []time.Duration
+	errors    map[string]int
+	/* Calls refused by the server's rate limits and retried */
+	limited int
+}
+
+/* Results gathered from every simulated player */
+type results struct {
+	mux     sync.Mutex
+	methods map[string]*methodStats
+	games   int
+	wins    int
+	losses  int
+	aborted int
+}
+
+/* Stats for method, callers hold mux */
+func (r *results) method(method string) *methodStats {
+	m, ok := r.methods[method]
+	if !ok {
+		m = &methodStats{errors: make(map[string]int)}
+		r.methods[method] = m
+	}
+	return m
+}
+
+/* Records a call's latency, and its status code if it failed */
+func (r *results) Observe(method string, d time.Duration, err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	m := r.method(method)
+	m.latencies = append(m.latencies, d)
+	if err != nil {
+		m.errors[status.Code(err).String()]++
+	}
+}
+
+/* Records how a game ended */
+func (r *results) Finish(g *hangmanv1.Game, username string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.games++
+	switch {
+	case g == nil || g.Active:
+		r.aborted++
+	case g.Winner == username:
+		r.wins++
+	default:
+		r.losses++
+	}
+}
+
+/* Returns the latency at quantile q of sorted latencies */
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+/* A simulated player */
+type player struct {
+	name     string
+	strategy string
+	sc       hangmanv1.HangmanServiceClient
+	res      *results
+	rng      *rand.Rand
+}
+
+/* Records a call refused by the server's rate limits */
+func (r *results) Limit(method string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.method(method).limited++
+}
+
+/* Makes a call, timing it under the method name. Calls refused by the server's */
+/* rate limits or game caps are counted apart and retried after backing off, so */
+/* the limits slow the run down rather than abort its games */
+func (p *player) call(ctx context.Context, method string, fn func() error) error {
+	backoff := minBackoff
+	for {
+		start := time.Now()
+		err := fn()
+		if status.Code(err) != codes.ResourceExhausted {
+			p.res.Observe(method, time.Since(start), err)
+			return err
+		}
+		p.res.Limit(method)
+
+		/* Jitter keeps players refused together from retrying together */
+		select {
+		case <-time.After(backoff/2 + time.Duration(p.rng.Int63n(int64(backoff)))):
+		case <-ctx.Done():
+			return err
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+/* Chooses the next letter for the player's strategy */
+func (p *player) next(ctx context.Context, g *hangmanv1.Game) (string, error) {
+	played := make(map[string]bool)
+	for _, l := range append(append([]string(nil), g.Hits...), g.Misses...) {
+		played[l] = true
+	}
+
+	strategy := p.strategy
+	if strategy == "mixed" {
+		strategy = []string{"random", "frequency", "solver"}[p.rng.Intn(3)]
+	}
+
+	switch strategy {
+	case "solver":
+		var hint *hangmanv1.SuggestLetterResponse
+		err := p.call(ctx, "SuggestLetter", func() (err error) {
+			hint, err = p.sc.SuggestLetter(ctx, &hangmanv1.SuggestLetterRequest{GameId: g.GameId, Username: p.name})
+			return err
+		})
+		if err != nil {
+			return "", err
+		}
+		return hint.Letter, nil
+	case "frequency":
+		for _, r := range letterFrequency {
+			if !played[string(r)] {
+				return string(r), nil
+			}
+		}
+	default:
+		for _, i := range p.rng.Perm(len(letterFrequency)) {
+			if l := letterFrequency[i : i+1]; !played[l] {
+				return l, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no letters left to play")
+}
+
+/* Plays one game from creation to completion */
+func (p *player) play(ctx context.Context) {
+	var created *hangmanv1.NewGameResponse
+	err := p.call(ctx, "NewGame", func() (err error) {
+		created, err = p.sc.NewGame(ctx, &hangmanv1.NewGameRequest{Username: p.name})
+		return err
+	})
+	if err != nil {
+		p.res.Finish(nil, p.name)
+		return
+	}
+
+	g := &hangmanv1.Game{GameId: created.GameId, Active: true}
+	for g.Active && ctx.Err() == nil {
+		letter, err := p.next(ctx, g)
+		if err != nil {
+			break
+		}
+
+		var res *hangmanv1.GuessResponse
+		err = p.call(ctx, "Guess", func() (err error) {
+			res, err = p.sc.Guess(ctx, &hangmanv1.GuessRequest{GameId: g.GameId, Letter: letter, Username: p.name})
+			return err
+		})
+		if err != nil {
+			break
+		}
+		g = res.Game
+	}
+
+	p.res.Finish(g, p.name)
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the hangman gRPC server")
+	players := flag.Int("players", 50, "number of simulated players")
+	games := flag.Int("games", 10, "games each player plays")
+	duration := flag.Duration("duration", 0, "stop after this long, even if games remain (0 plays every game)")
+	strategy := flag.String("strategy", "frequency", "how players guess: random, frequency, solver or mixed")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "seed for random and mixed strategies")
+	useTLS := flag.Bool("tls", false, "connect over TLS, verifying the server against the system roots")
+	ca := flag.String("ca", "", "PEM CA certificate to verify the server with (implies -tls)")
+	flag.Parse()
+
+	if !strategies[*strategy] {
+		fmt.Fprintf(os.Stderr, "Invalid strategy %q, want random, frequency, solver or mixed\n", *strategy)
+		os.Exit(2)
+	}
+	if *players < 1 || *games < 1 {
+		fmt.Fprintf(os.Stderr, "-players and -games must be at least 1\n")
+		os.Exit(2)
+	}
+
+	creds := insecure.NewCredentials()
+	switch {
+	case *ca != "":
+		caCreds, err := credentials.NewClientTLSFromFile(*ca, "")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid -ca %q: %v\n", *ca, err)
+			os.Exit(2)
+		}
+		creds = caCreds
+	case *useTLS:
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+
+	cc, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to dial %s: %v\n", *addr, err)
+		os.Exit(1)
+	}
+	defer cc.Close()
+
+	ctx := context.Background()
+	if *duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *duration)
+		defer cancel()
+	}
+
+	res := &results{methods: make(map[string]*methodStats)}
+	sc := hangmanv1.NewHangmanServiceClient(cc)
+
+	fmt.Printf("Running %d players x %d games against %s with %s strategy\n", *players, *games, *addr, *strategy)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < *players; i++ {
+		p := &player{
+			name:     fmt.Sprintf("bench-%d", i),
+			strategy: *strategy,
+			sc:       sc,
+			res:      res,
+			rng:      rand.New(rand.NewSource(*seed + int64(i))),
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < *games && ctx.Err() == nil; n++ {
+				p.play(ctx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	report(res, time.Since(start))
+}
+
+/* Prints throughput, per-method latency percentiles and errors */
+func report(res *results, elapsed time.Duration) {
+	names := make([]string, 0, len(res.methods))
+	calls := 0
+	for name, m := range res.methods {
+		names = append(names, name)
+		calls += len(m.latencies)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("\nElapsed:    %v\n", elapsed.Round(time.Millisecond))
+	fmt.Printf("Games:      %d (%d won, %d lost, %d aborted)\n", res.games, res.wins, res.losses, res.aborted)
+	fmt.Printf("Throughput: %.1f games/s, %.1f calls/s\n", float64(res.games)/elapsed.Seconds(), float64(calls)/elapsed.Seconds())
+
+	fmt.Printf("\nMETHOD | CALLS | P50 | P90 | P99 | MAX | RATE LIMITED | ERRORS\n")
+	for _, name := range names {
+		m := res.methods[name]
+		sort.Slice(m.latencies, func(i, j int) bool { return m.latencies[i] < m.latencies[j] })
+
+		var errs []string
+		for code, n := range m.errors {
+			errs = append(errs, fmt.Sprintf("%s=%d", code, n))
+		}
+		sort.Strings(errs)
+
+		fmt.Printf("   %s   %d   %v   %v   %v   %v   %d   %s\n",
+			name,
+			len(m.latencies),
+			percentile(m.latencies, 0.50),
+			percentile(m.latencies, 0.90),
+			percentile(m.latencies, 0.99),
+			percentile(m.latencies, 1),
+			m.limited,
+			strings.Join(errs, ","),
+		)
+	}
+}
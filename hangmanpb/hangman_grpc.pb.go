@@ -0,0 +1,346 @@
+// Deprecated: single-RPC services kept as compatibility shims while clients
+// migrate to hangman.v1.HangmanService (see v1/hangman.proto).
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: hangmanpb/hangman.proto
+
+package hangmanpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuessService_Guess_FullMethodName = "/hangman.GuessService/Guess"
+)
+
+// GuessServiceClient is the client API for GuessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Deprecated: Do not use.
+type GuessServiceClient interface {
+	Guess(ctx context.Context, in *GuessRequest, opts ...grpc.CallOption) (*GuessResponse, error)
+}
+
+type guessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// Deprecated: Do not use.
+func NewGuessServiceClient(cc grpc.ClientConnInterface) GuessServiceClient {
+	return &guessServiceClient{cc}
+}
+
+func (c *guessServiceClient) Guess(ctx context.Context, in *GuessRequest, opts ...grpc.CallOption) (*GuessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuessResponse)
+	err := c.cc.Invoke(ctx, GuessService_Guess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuessServiceServer is the server API for GuessService service.
+// All implementations must embed UnimplementedGuessServiceServer
+// for forward compatibility.
+//
+// Deprecated: Do not use.
+type GuessServiceServer interface {
+	Guess(context.Context, *GuessRequest) (*GuessResponse, error)
+	mustEmbedUnimplementedGuessServiceServer()
+}
+
+// UnimplementedGuessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuessServiceServer struct{}
+
+func (UnimplementedGuessServiceServer) Guess(context.Context, *GuessRequest) (*GuessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guess not implemented")
+}
+func (UnimplementedGuessServiceServer) mustEmbedUnimplementedGuessServiceServer() {}
+func (UnimplementedGuessServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGuessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuessServiceServer will
+// result in compilation errors.
+type UnsafeGuessServiceServer interface {
+	mustEmbedUnimplementedGuessServiceServer()
+}
+
+// Deprecated: Do not use.
+func RegisterGuessServiceServer(s grpc.ServiceRegistrar, srv GuessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuessService_ServiceDesc, srv)
+}
+
+func _GuessService_Guess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuessServiceServer).Guess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuessService_Guess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuessServiceServer).Guess(ctx, req.(*GuessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuessService_ServiceDesc is the grpc.ServiceDesc for GuessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hangman.GuessService",
+	HandlerType: (*GuessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Guess",
+			Handler:    _GuessService_Guess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hangmanpb/hangman.proto",
+}
+
+const (
+	NewGameService_NewGame_FullMethodName = "/hangman.NewGameService/NewGame"
+)
+
+// NewGameServiceClient is the client API for NewGameService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Deprecated: Do not use.
+type NewGameServiceClient interface {
+	NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*NewGameResponse, error)
+}
+
+type newGameServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// Deprecated: Do not use.
+func NewNewGameServiceClient(cc grpc.ClientConnInterface) NewGameServiceClient {
+	return &newGameServiceClient{cc}
+}
+
+func (c *newGameServiceClient) NewGame(ctx context.Context, in *NewGameRequest, opts ...grpc.CallOption) (*NewGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewGameResponse)
+	err := c.cc.Invoke(ctx, NewGameService_NewGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewGameServiceServer is the server API for NewGameService service.
+// All implementations must embed UnimplementedNewGameServiceServer
+// for forward compatibility.
+//
+// Deprecated: Do not use.
+type NewGameServiceServer interface {
+	NewGame(context.Context, *NewGameRequest) (*NewGameResponse, error)
+	mustEmbedUnimplementedNewGameServiceServer()
+}
+
+// UnimplementedNewGameServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNewGameServiceServer struct{}
+
+func (UnimplementedNewGameServiceServer) NewGame(context.Context, *NewGameRequest) (*NewGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGame not implemented")
+}
+func (UnimplementedNewGameServiceServer) mustEmbedUnimplementedNewGameServiceServer() {}
+func (UnimplementedNewGameServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeNewGameServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NewGameServiceServer will
+// result in compilation errors.
+type UnsafeNewGameServiceServer interface {
+	mustEmbedUnimplementedNewGameServiceServer()
+}
+
+// Deprecated: Do not use.
+func RegisterNewGameServiceServer(s grpc.ServiceRegistrar, srv NewGameServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNewGameServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NewGameService_ServiceDesc, srv)
+}
+
+func _NewGameService_NewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewGameServiceServer).NewGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewGameService_NewGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewGameServiceServer).NewGame(ctx, req.(*NewGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NewGameService_ServiceDesc is the grpc.ServiceDesc for NewGameService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NewGameService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hangman.NewGameService",
+	HandlerType: (*NewGameServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewGame",
+			Handler:    _NewGameService_NewGame_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hangmanpb/hangman.proto",
+}
+
+const (
+	ListService_List_FullMethodName = "/hangman.ListService/List"
+)
+
+// ListServiceClient is the client API for ListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Deprecated: Do not use.
+type ListServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+}
+
+type listServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// Deprecated: Do not use.
+func NewListServiceClient(cc grpc.ClientConnInterface) ListServiceClient {
+	return &listServiceClient{cc}
+}
+
+func (c *listServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, ListService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServiceServer is the server API for ListService service.
+// All implementations must embed UnimplementedListServiceServer
+// for forward compatibility.
+//
+// Deprecated: Do not use.
+type ListServiceServer interface {
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	mustEmbedUnimplementedListServiceServer()
+}
+
+// UnimplementedListServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedListServiceServer struct{}
+
+func (UnimplementedListServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedListServiceServer) mustEmbedUnimplementedListServiceServer() {}
+func (UnimplementedListServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListServiceServer will
+// result in compilation errors.
+type UnsafeListServiceServer interface {
+	mustEmbedUnimplementedListServiceServer()
+}
+
+// Deprecated: Do not use.
+func RegisterListServiceServer(s grpc.ServiceRegistrar, srv ListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ListService_ServiceDesc, srv)
+}
+
+func _ListService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ListService_ServiceDesc is the grpc.ServiceDesc for ListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hangman.ListService",
+	HandlerType: (*ListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ListService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hangmanpb/hangman.proto",
+}
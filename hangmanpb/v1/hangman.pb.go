@@ -0,0 +1,3868 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: hangmanpb/v1/hangman.proto
+
+package hangmanv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BotSkill int32
+
+const (
+	BotSkill_BOT_SKILL_UNSPECIFIED BotSkill = 0
+	// Guesses any letter not yet played.
+	BotSkill_BOT_SKILL_RANDOM BotSkill = 1
+	// Guesses the most common English letter not yet played.
+	BotSkill_BOT_SKILL_FREQUENCY BotSkill = 2
+	// Guesses the letter SuggestLetter ranks best.
+	BotSkill_BOT_SKILL_OPTIMAL BotSkill = 3
+)
+
+// Enum value maps for BotSkill.
+var (
+	BotSkill_name = map[int32]string{
+		0: "BOT_SKILL_UNSPECIFIED",
+		1: "BOT_SKILL_RANDOM",
+		2: "BOT_SKILL_FREQUENCY",
+		3: "BOT_SKILL_OPTIMAL",
+	}
+	BotSkill_value = map[string]int32{
+		"BOT_SKILL_UNSPECIFIED": 0,
+		"BOT_SKILL_RANDOM":      1,
+		"BOT_SKILL_FREQUENCY":   2,
+		"BOT_SKILL_OPTIMAL":     3,
+	}
+)
+
+func (x BotSkill) Enum() *BotSkill {
+	p := new(BotSkill)
+	*p = x
+	return p
+}
+
+func (x BotSkill) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BotSkill) Descriptor() protoreflect.EnumDescriptor {
+	return file_hangmanpb_v1_hangman_proto_enumTypes[0].Descriptor()
+}
+
+func (BotSkill) Type() protoreflect.EnumType {
+	return &file_hangmanpb_v1_hangman_proto_enumTypes[0]
+}
+
+func (x BotSkill) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BotSkill.Descriptor instead.
+func (BotSkill) EnumDescriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{0}
+}
+
+type TournamentFormat int32
+
+const (
+	TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED TournamentFormat = 0
+	// Every player meets every other player once; most wins takes the title.
+	TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN TournamentFormat = 1
+	// Winners advance until one remains; drawn matches are replayed.
+	TournamentFormat_TOURNAMENT_FORMAT_KNOCKOUT TournamentFormat = 2
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "TOURNAMENT_FORMAT_UNSPECIFIED",
+		1: "TOURNAMENT_FORMAT_ROUND_ROBIN",
+		2: "TOURNAMENT_FORMAT_KNOCKOUT",
+	}
+	TournamentFormat_value = map[string]int32{
+		"TOURNAMENT_FORMAT_UNSPECIFIED": 0,
+		"TOURNAMENT_FORMAT_ROUND_ROBIN": 1,
+		"TOURNAMENT_FORMAT_KNOCKOUT":    2,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_hangmanpb_v1_hangman_proto_enumTypes[1].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_hangmanpb_v1_hangman_proto_enumTypes[1]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{1}
+}
+
+// Player-facing view of a game; the secret word is never included.
+type Game struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GameId    int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WordState string                 `protobuf:"bytes,2,opt,name=word_state,json=wordState,proto3" json:"word_state,omitempty"`
+	Turns     int32                  `protobuf:"varint,3,opt,name=turns,proto3" json:"turns,omitempty"`
+	Active    bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Winner    string                 `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	MaxTurns  int32                  `protobuf:"varint,6,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+	// Guessed letters found in the word, in the order they were played.
+	Hits []string `protobuf:"bytes,7,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Guessed letters not in the word, in the order they were played.
+	Misses []string `protobuf:"bytes,8,rep,name=misses,proto3" json:"misses,omitempty"`
+	// Set for games hosted as rooms, which players must join before guessing.
+	Room bool `protobuf:"varint,9,opt,name=room,proto3" json:"room,omitempty"`
+	// Private rooms are left out of List.
+	Private       bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
+	Evil          bool `protobuf:"varint,11,opt,name=evil,proto3" json:"evil,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Game) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Game) GetWordState() string {
+	if x != nil {
+		return x.WordState
+	}
+	return ""
+}
+
+func (x *Game) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *Game) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Game) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Game) GetMaxTurns() int32 {
+	if x != nil {
+		return x.MaxTurns
+	}
+	return 0
+}
+
+func (x *Game) GetHits() []string {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *Game) GetMisses() []string {
+	if x != nil {
+		return x.Misses
+	}
+	return nil
+}
+
+func (x *Game) GetRoom() bool {
+	if x != nil {
+		return x.Room
+	}
+	return false
+}
+
+func (x *Game) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Game) GetEvil() bool {
+	if x != nil {
+		return x.Evil
+	}
+	return false
+}
+
+type NewGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chooses the word reproducibly: the same seed gives the same word while
+	// the server's word list is unchanged. Omit for a random word.
+	Seed *int64 `protobuf:"varint,1,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	// Plays evil hangman: the server keeps every dictionary word of the
+	// chosen length in play, revealing letters only when forced.
+	Evil bool `protobuf:"varint,2,opt,name=evil,proto3" json:"evil,omitempty"`
+	// Player starting the game, counted against the server's limit on active
+	// games per user. Defaults to the caller's address when empty.
+	Username      string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewGameRequest) Reset() {
+	*x = NewGameRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameRequest) ProtoMessage() {}
+
+func (x *NewGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameRequest.ProtoReflect.Descriptor instead.
+func (*NewGameRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NewGameRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+func (x *NewGameRequest) GetEvil() bool {
+	if x != nil {
+		return x.Evil
+	}
+	return false
+}
+
+func (x *NewGameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type NewGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewGameResponse) Reset() {
+	*x = NewGameResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameResponse) ProtoMessage() {}
+
+func (x *NewGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameResponse.ProtoReflect.Descriptor instead.
+func (*NewGameResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NewGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type ListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{3}
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GuessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Letter        string                 `protobuf:"bytes,2,opt,name=letter,proto3" json:"letter,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessRequest) Reset() {
+	*x = GuessRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessRequest) ProtoMessage() {}
+
+func (x *GuessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessRequest.ProtoReflect.Descriptor instead.
+func (*GuessRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GuessRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GuessRequest) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *GuessRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GuessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Detail        []string               `protobuf:"bytes,2,rep,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessResponse) Reset() {
+	*x = GuessResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessResponse) ProtoMessage() {}
+
+func (x *GuessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessResponse.ProtoReflect.Descriptor instead.
+func (*GuessResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GuessResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GuessResponse) GetDetail() []string {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+type GetGameRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Required to see a private room, which only its players may.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GetGameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// Full state of one game. Timestamps are Unix nanoseconds; ended_unix_nano
+// is 0 while the game is still being played.
+type GetGameResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Game                 *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Players              []string               `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	CreatedUnixNano      int64                  `protobuf:"varint,5,opt,name=created_unix_nano,json=createdUnixNano,proto3" json:"created_unix_nano,omitempty"`
+	LastActivityUnixNano int64                  `protobuf:"varint,6,opt,name=last_activity_unix_nano,json=lastActivityUnixNano,proto3" json:"last_activity_unix_nano,omitempty"`
+	EndedUnixNano        int64                  `protobuf:"varint,7,opt,name=ended_unix_nano,json=endedUnixNano,proto3" json:"ended_unix_nano,omitempty"`
+	// Players which are server-side bots.
+	Bots          []string `protobuf:"bytes,8,rep,name=bots,proto3" json:"bots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameResponse) Reset() {
+	*x = GetGameResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResponse) ProtoMessage() {}
+
+func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetCreatedUnixNano() int64 {
+	if x != nil {
+		return x.CreatedUnixNano
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetLastActivityUnixNano() int64 {
+	if x != nil {
+		return x.LastActivityUnixNano
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetEndedUnixNano() int64 {
+	if x != nil {
+		return x.EndedUnixNano
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetBots() []string {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GameId   int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Text     string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// Set instead of text when the message is an emoji reaction.
+	Reaction      string `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	SentUnixNano  int64  `protobuf:"varint,5,opt,name=sent_unix_nano,json=sentUnixNano,proto3" json:"sent_unix_nano,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChatMessage) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentUnixNano() int64 {
+	if x != nil {
+		return x.SentUnixNano
+	}
+	return 0
+}
+
+// The first request on a GameChat stream joins the game's chat as username
+// and may carry no text; later requests post text or a reaction.
+type GameChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Reaction      string                 `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameChatRequest) Reset() {
+	*x = GameChatRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameChatRequest) ProtoMessage() {}
+
+func (x *GameChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameChatRequest.ProtoReflect.Descriptor instead.
+func (*GameChatRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GameChatRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameChatRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GameChatRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GameChatRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type GameChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameChatResponse) Reset() {
+	*x = GameChatResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameChatResponse) ProtoMessage() {}
+
+func (x *GameChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameChatResponse.ProtoReflect.Descriptor instead.
+func (*GameChatResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GameChatResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Each player has one attempt per day at the daily puzzle, so it is addressed
+// by username rather than game_id. The game's game_id is always 0.
+type GetDailyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyRequest) Reset() {
+	*x = GetDailyRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyRequest) ProtoMessage() {}
+
+func (x *GetDailyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetDailyRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetDailyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UTC date of the puzzle, as YYYY-MM-DD.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Game *Game  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	// Emoji grid summarising the attempt, set once it is finished.
+	Share         string `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyResponse) Reset() {
+	*x = GetDailyResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyResponse) ProtoMessage() {}
+
+func (x *GetDailyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDailyResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GetDailyResponse) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+type GuessDailyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Letter        string                 `protobuf:"bytes,2,opt,name=letter,proto3" json:"letter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessDailyRequest) Reset() {
+	*x = GuessDailyRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessDailyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessDailyRequest) ProtoMessage() {}
+
+func (x *GuessDailyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessDailyRequest.ProtoReflect.Descriptor instead.
+func (*GuessDailyRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GuessDailyRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GuessDailyRequest) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+type GuessDailyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	Detail        []string               `protobuf:"bytes,3,rep,name=detail,proto3" json:"detail,omitempty"`
+	Share         string                 `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuessDailyResponse) Reset() {
+	*x = GuessDailyResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuessDailyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuessDailyResponse) ProtoMessage() {}
+
+func (x *GuessDailyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuessDailyResponse.ProtoReflect.Descriptor instead.
+func (*GuessDailyResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GuessDailyResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GuessDailyResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GuessDailyResponse) GetDetail() []string {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *GuessDailyResponse) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+// A room is a game which players join with a short code before guessing.
+type CreateRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The host joins the room on creation.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Required to join when set.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Limit on players who may join, host included. 0 means no limit.
+	MaxPlayers int32 `protobuf:"varint,3,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// Leaves the room out of List.
+	Private       bool   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	Seed          *int64 `protobuf:"varint,5,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateRoomRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateRoomResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *CreateRoomResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JoinRoomRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type JoinRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomResponse) Reset() {
+	*x = JoinRoomResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomResponse) ProtoMessage() {}
+
+func (x *JoinRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomResponse.ProtoReflect.Descriptor instead.
+func (*JoinRoomResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JoinRoomResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type SuggestLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestLetterRequest) Reset() {
+	*x = SuggestLetterRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestLetterRequest) ProtoMessage() {}
+
+func (x *SuggestLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestLetterRequest.ProtoReflect.Descriptor instead.
+func (*SuggestLetterRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuggestLetterRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *SuggestLetterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type LetterScore struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Letter string                 `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// Share of candidate words containing the letter.
+	Probability float64 `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
+	// Bits of information expected from guessing the letter.
+	Entropy       float64 `protobuf:"fixed64,3,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LetterScore) Reset() {
+	*x = LetterScore{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LetterScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterScore) ProtoMessage() {}
+
+func (x *LetterScore) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LetterScore.ProtoReflect.Descriptor instead.
+func (*LetterScore) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LetterScore) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *LetterScore) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *LetterScore) GetEntropy() float64 {
+	if x != nil {
+		return x.Entropy
+	}
+	return 0
+}
+
+type SuggestLetterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The best letter to guess next.
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	// Every letter not yet played, best first.
+	Ranked []*LetterScore `protobuf:"bytes,2,rep,name=ranked,proto3" json:"ranked,omitempty"`
+	// Dictionary words still consistent with the game.
+	Candidates int32 `protobuf:"varint,3,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// Turns the hint cost, already deducted from game.
+	TurnsCost     int32 `protobuf:"varint,4,opt,name=turns_cost,json=turnsCost,proto3" json:"turns_cost,omitempty"`
+	Game          *Game `protobuf:"bytes,5,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestLetterResponse) Reset() {
+	*x = SuggestLetterResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestLetterResponse) ProtoMessage() {}
+
+func (x *SuggestLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestLetterResponse.ProtoReflect.Descriptor instead.
+func (*SuggestLetterResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SuggestLetterResponse) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *SuggestLetterResponse) GetRanked() []*LetterScore {
+	if x != nil {
+		return x.Ranked
+	}
+	return nil
+}
+
+func (x *SuggestLetterResponse) GetCandidates() int32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *SuggestLetterResponse) GetTurnsCost() int32 {
+	if x != nil {
+		return x.TurnsCost
+	}
+	return 0
+}
+
+func (x *SuggestLetterResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// Bots are named "bot-<skill>", a prefix reserved for them. They cannot join
+// tournaments and take one turn each after every human guess. Only a player
+// who may guess in the game, such as a room's host or invitees, may add one.
+// Bots take no seats from a room's max_players.
+type AddBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Skill         BotSkill               `protobuf:"varint,2,opt,name=skill,proto3,enum=hangman.v1.BotSkill" json:"skill,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotRequest) Reset() {
+	*x = AddBotRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotRequest) ProtoMessage() {}
+
+func (x *AddBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotRequest.ProtoReflect.Descriptor instead.
+func (*AddBotRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddBotRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *AddBotRequest) GetSkill() BotSkill {
+	if x != nil {
+		return x.Skill
+	}
+	return BotSkill_BOT_SKILL_UNSPECIFIED
+}
+
+func (x *AddBotRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AddBotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotResponse) Reset() {
+	*x = AddBotResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotResponse) ProtoMessage() {}
+
+func (x *AddBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotResponse.ProtoReflect.Descriptor instead.
+func (*AddBotResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddBotResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddBotResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{25}
+}
+
+type PingResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ServerVersion      string                 `protobuf:"bytes,1,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	ServerTimeUnixNano int64                  `protobuf:"varint,2,opt,name=server_time_unix_nano,json=serverTimeUnixNano,proto3" json:"server_time_unix_nano,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PingResponse) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *PingResponse) GetServerTimeUnixNano() int64 {
+	if x != nil {
+		return x.ServerTimeUnixNano
+	}
+	return 0
+}
+
+type AdminGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayWord      string                 `protobuf:"bytes,2,opt,name=play_word,json=playWord,proto3" json:"play_word,omitempty"`
+	CompleteWord  string                 `protobuf:"bytes,3,opt,name=complete_word,json=completeWord,proto3" json:"complete_word,omitempty"`
+	Turns         int32                  `protobuf:"varint,5,opt,name=turns,proto3" json:"turns,omitempty"`
+	Winner        string                 `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	Players       []string               `protobuf:"bytes,8,rep,name=players,proto3" json:"players,omitempty"`
+	Hits          []string               `protobuf:"bytes,9,rep,name=hits,proto3" json:"hits,omitempty"`
+	Misses        []string               `protobuf:"bytes,10,rep,name=misses,proto3" json:"misses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGame) Reset() {
+	*x = AdminGame{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGame) ProtoMessage() {}
+
+func (x *AdminGame) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGame.ProtoReflect.Descriptor instead.
+func (*AdminGame) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AdminGame) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *AdminGame) GetPlayWord() string {
+	if x != nil {
+		return x.PlayWord
+	}
+	return ""
+}
+
+func (x *AdminGame) GetCompleteWord() string {
+	if x != nil {
+		return x.CompleteWord
+	}
+	return ""
+}
+
+func (x *AdminGame) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *AdminGame) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *AdminGame) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AdminGame) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *AdminGame) GetHits() []string {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *AdminGame) GetMisses() []string {
+	if x != nil {
+		return x.Misses
+	}
+	return nil
+}
+
+type ListAllGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllGamesRequest) Reset() {
+	*x = ListAllGamesRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllGamesRequest) ProtoMessage() {}
+
+func (x *ListAllGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllGamesRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{28}
+}
+
+type ListAllGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*AdminGame           `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllGamesResponse) Reset() {
+	*x = ListAllGamesResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllGamesResponse) ProtoMessage() {}
+
+func (x *ListAllGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllGamesResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAllGamesResponse) GetGames() []*AdminGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type EndGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndGameRequest) Reset() {
+	*x = EndGameRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameRequest) ProtoMessage() {}
+
+func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
+func (*EndGameRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EndGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type EndGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *AdminGame             `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndGameResponse) Reset() {
+	*x = EndGameResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameResponse) ProtoMessage() {}
+
+func (x *EndGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameResponse.ProtoReflect.Descriptor instead.
+func (*EndGameResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EndGameResponse) GetGame() *AdminGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type ResetGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetGameRequest) Reset() {
+	*x = ResetGameRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetGameRequest) ProtoMessage() {}
+
+func (x *ResetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetGameRequest.ProtoReflect.Descriptor instead.
+func (*ResetGameRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResetGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type ResetGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *AdminGame             `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetGameResponse) Reset() {
+	*x = ResetGameResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetGameResponse) ProtoMessage() {}
+
+func (x *ResetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetGameResponse.ProtoReflect.Descriptor instead.
+func (*ResetGameResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResetGameResponse) GetGame() *AdminGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type KickUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRequest) ProtoMessage() {}
+
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *KickUserRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *KickUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type KickUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *AdminGame             `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserResponse) Reset() {
+	*x = KickUserResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserResponse) ProtoMessage() {}
+
+func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
+func (*KickUserResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *KickUserResponse) GetGame() *AdminGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Unban         bool                   `protobuf:"varint,2,opt,name=unban,proto3" json:"unban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BanUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetUnban() bool {
+	if x != nil {
+		return x.Unban
+	}
+	return false
+}
+
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Banned        []string               `protobuf:"bytes,1,rep,name=banned,proto3" json:"banned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BanUserResponse) GetBanned() []string {
+	if x != nil {
+		return x.Banned
+	}
+	return nil
+}
+
+type ReloadWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadWordsRequest) Reset() {
+	*x = ReloadWordsRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadWordsRequest) ProtoMessage() {}
+
+func (x *ReloadWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadWordsRequest.ProtoReflect.Descriptor instead.
+func (*ReloadWordsRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{40}
+}
+
+type ReloadWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WordCount     int32                  `protobuf:"varint,1,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadWordsResponse) Reset() {
+	*x = ReloadWordsResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadWordsResponse) ProtoMessage() {}
+
+func (x *ReloadWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadWordsResponse.ProtoReflect.Descriptor instead.
+func (*ReloadWordsResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReloadWordsResponse) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type SetDefaultTurnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Turns         int32                  `protobuf:"varint,1,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultTurnsRequest) Reset() {
+	*x = SetDefaultTurnsRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultTurnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultTurnsRequest) ProtoMessage() {}
+
+func (x *SetDefaultTurnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultTurnsRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultTurnsRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetDefaultTurnsRequest) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+type SetDefaultTurnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousTurns int32                  `protobuf:"varint,1,opt,name=previous_turns,json=previousTurns,proto3" json:"previous_turns,omitempty"`
+	Turns         int32                  `protobuf:"varint,2,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultTurnsResponse) Reset() {
+	*x = SetDefaultTurnsResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultTurnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultTurnsResponse) ProtoMessage() {}
+
+func (x *SetDefaultTurnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultTurnsResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultTurnsResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetDefaultTurnsResponse) GetPreviousTurns() int32 {
+	if x != nil {
+		return x.PreviousTurns
+	}
+	return 0
+}
+
+func (x *SetDefaultTurnsResponse) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+// A pairing decided by a hangman game between its players, won by whoever
+// reveals the word. A match with a single player is a bye.
+type Match struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Round   int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Players []string               `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	// -1 until the match's round starts.
+	GameId   int32 `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Finished bool  `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+	// Empty for a drawn match, where nobody revealed the word.
+	Winner string `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	// Games played, more than one when a knockout match was replayed.
+	GamesPlayed   int32 `protobuf:"varint,6,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Match) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Match) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Match) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Match) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *Match) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Match) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+type Tournament struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format       TournamentFormat       `protobuf:"varint,3,opt,name=format,proto3,enum=hangman.v1.TournamentFormat" json:"format,omitempty"`
+	Players      []string               `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	// 0 until the tournament is started.
+	CurrentRound  int32    `protobuf:"varint,5,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
+	TotalRounds   int32    `protobuf:"varint,6,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	Finished      bool     `protobuf:"varint,7,opt,name=finished,proto3" json:"finished,omitempty"`
+	Champion      string   `protobuf:"bytes,8,opt,name=champion,proto3" json:"champion,omitempty"`
+	Matches       []*Match `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Tournament) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *Tournament) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Tournament) GetCurrentRound() int32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
+}
+
+func (x *Tournament) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *Tournament) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *Tournament) GetChampion() string {
+	if x != nil {
+		return x.Champion
+	}
+	return ""
+}
+
+func (x *Tournament) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type Standing struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Played   int32                  `protobuf:"varint,2,opt,name=played,proto3" json:"played,omitempty"`
+	Wins     int32                  `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Draws    int32                  `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses   int32                  `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Knocked out of a knockout tournament.
+	Eliminated    bool `protobuf:"varint,6,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Standing) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Standing) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *Standing) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+type CreateTournamentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format  TournamentFormat       `protobuf:"varint,2,opt,name=format,proto3,enum=hangman.v1.TournamentFormat" json:"format,omitempty"`
+	Players []string               `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// Makes the tournament's words reproducible, as NewGameRequest.seed.
+	Seed          *int64 `protobuf:"varint,4,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *CreateTournamentRequest) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+type CreateTournamentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentResponse) ProtoMessage() {}
+
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type RegisterPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPlayerRequest) Reset() {
+	*x = RegisterPlayerRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerRequest) ProtoMessage() {}
+
+func (x *RegisterPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RegisterPlayerRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *RegisterPlayerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RegisterPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPlayerResponse) Reset() {
+	*x = RegisterPlayerResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPlayerResponse) ProtoMessage() {}
+
+func (x *RegisterPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPlayerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RegisterPlayerResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type StartTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTournamentRequest) ProtoMessage() {}
+
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StartTournamentRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+type StartTournamentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTournamentResponse) Reset() {
+	*x = StartTournamentResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTournamentResponse) ProtoMessage() {}
+
+func (x *StartTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTournamentResponse.ProtoReflect.Descriptor instead.
+func (*StartTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type GetTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentRequest) ProtoMessage() {}
+
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetTournamentRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+type GetTournamentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTournamentResponse) Reset() {
+	*x = GetTournamentResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTournamentResponse) ProtoMessage() {}
+
+func (x *GetTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTournamentResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type ListTournamentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTournamentsRequest) Reset() {
+	*x = ListTournamentsRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTournamentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsRequest) ProtoMessage() {}
+
+func (x *ListTournamentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTournamentsRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{55}
+}
+
+type ListTournamentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournaments   []*Tournament          `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTournamentsResponse) Reset() {
+	*x = ListTournamentsResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTournamentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTournamentsResponse) ProtoMessage() {}
+
+func (x *ListTournamentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTournamentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTournamentsResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListTournamentsResponse) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetStandingsRequest) GetTournamentId() int32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+type GetStandingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by wins, then draws, then fewest losses.
+	Standings     []*Standing `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingsResponse) Reset() {
+	*x = GetStandingsResponse{}
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsResponse) ProtoMessage() {}
+
+func (x *GetStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hangmanpb_v1_hangman_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_hangmanpb_v1_hangman_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetStandingsResponse) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+var File_hangmanpb_v1_hangman_proto protoreflect.FileDescriptor
+
+const file_hangmanpb_v1_hangman_proto_rawDesc = "" +
+	"\n" +
+	"\x1ahangmanpb/v1/hangman.proto\x12\n" +
+	"hangman.v1\x1a\x1cgoogle/api/annotations.proto\"\x8f\x02\n" +
+	"\x04Game\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1d\n" +
+	"\n" +
+	"word_state\x18\x02 \x01(\tR\twordState\x12\x14\n" +
+	"\x05turns\x18\x03 \x01(\x05R\x05turns\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12\x16\n" +
+	"\x06winner\x18\x05 \x01(\tR\x06winner\x12\x1b\n" +
+	"\tmax_turns\x18\x06 \x01(\x05R\bmaxTurns\x12\x12\n" +
+	"\x04hits\x18\a \x03(\tR\x04hits\x12\x16\n" +
+	"\x06misses\x18\b \x03(\tR\x06misses\x12\x12\n" +
+	"\x04room\x18\t \x01(\bR\x04room\x12\x18\n" +
+	"\aprivate\x18\n" +
+	" \x01(\bR\aprivate\x12\x12\n" +
+	"\x04evil\x18\v \x01(\bR\x04evil\"b\n" +
+	"\x0eNewGameRequest\x12\x17\n" +
+	"\x04seed\x18\x01 \x01(\x03H\x00R\x04seed\x88\x01\x01\x12\x12\n" +
+	"\x04evil\x18\x02 \x01(\bR\x04evil\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busernameB\a\n" +
+	"\x05_seed\"*\n" +
+	"\x0fNewGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\"\r\n" +
+	"\vListRequest\"6\n" +
+	"\fListResponse\x12&\n" +
+	"\x05games\x18\x01 \x03(\v2\x10.hangman.v1.GameR\x05games\"[\n" +
+	"\fGuessRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x16\n" +
+	"\x06letter\x18\x02 \x01(\tR\x06letter\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"M\n" +
+	"\rGuessResponse\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.hangman.v1.GameR\x04game\x12\x16\n" +
+	"\x06detail\x18\x02 \x03(\tR\x06detail\"E\n" +
+	"\x0eGetGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x8a\x02\n" +
+	"\x0fGetGameResponse\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.hangman.v1.GameR\x04game\x12\x18\n" +
+	"\aplayers\x18\x04 \x03(\tR\aplayers\x12*\n" +
+	"\x11created_unix_nano\x18\x05 \x01(\x03R\x0fcreatedUnixNano\x125\n" +
+	"\x17last_activity_unix_nano\x18\x06 \x01(\x03R\x14lastActivityUnixNano\x12&\n" +
+	"\x0fended_unix_nano\x18\a \x01(\x03R\rendedUnixNano\x12\x12\n" +
+	"\x04bots\x18\b \x03(\tR\x04botsJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04R\x04hitsR\x06misses\"\x98\x01\n" +
+	"\vChatMessage\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1a\n" +
+	"\breaction\x18\x04 \x01(\tR\breaction\x12$\n" +
+	"\x0esent_unix_nano\x18\x05 \x01(\x03R\fsentUnixNano\"v\n" +
+	"\x0fGameChatRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1a\n" +
+	"\breaction\x18\x04 \x01(\tR\breaction\"E\n" +
+	"\x10GameChatResponse\x121\n" +
+	"\amessage\x18\x01 \x01(\v2\x17.hangman.v1.ChatMessageR\amessage\"-\n" +
+	"\x0fGetDailyRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"b\n" +
+	"\x10GetDailyResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12$\n" +
+	"\x04game\x18\x02 \x01(\v2\x10.hangman.v1.GameR\x04game\x12\x14\n" +
+	"\x05share\x18\x03 \x01(\tR\x05share\"G\n" +
+	"\x11GuessDailyRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06letter\x18\x02 \x01(\tR\x06letter\"|\n" +
+	"\x12GuessDailyResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12$\n" +
+	"\x04game\x18\x02 \x01(\v2\x10.hangman.v1.GameR\x04game\x12\x16\n" +
+	"\x06detail\x18\x03 \x03(\tR\x06detail\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\tR\x05share\"\xa0\x01\n" +
+	"\x11CreateRoomRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vmax_players\x18\x03 \x01(\x05R\n" +
+	"maxPlayers\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\x12\x17\n" +
+	"\x04seed\x18\x05 \x01(\x03H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"A\n" +
+	"\x12CreateRoomResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"]\n" +
+	"\x0fJoinRoomRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"8\n" +
+	"\x10JoinRoomResponse\x12$\n" +
+	"\x04game\x18\x01 \x01(\v2\x10.hangman.v1.GameR\x04game\"K\n" +
+	"\x14SuggestLetterRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"a\n" +
+	"\vLetterScore\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12 \n" +
+	"\vprobability\x18\x02 \x01(\x01R\vprobability\x12\x18\n" +
+	"\aentropy\x18\x03 \x01(\x01R\aentropy\"\xc5\x01\n" +
+	"\x15SuggestLetterResponse\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12/\n" +
+	"\x06ranked\x18\x02 \x03(\v2\x17.hangman.v1.LetterScoreR\x06ranked\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x03 \x01(\x05R\n" +
+	"candidates\x12\x1d\n" +
+	"\n" +
+	"turns_cost\x18\x04 \x01(\x05R\tturnsCost\x12$\n" +
+	"\x04game\x18\x05 \x01(\v2\x10.hangman.v1.GameR\x04game\"p\n" +
+	"\rAddBotRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12*\n" +
+	"\x05skill\x18\x02 \x01(\x0e2\x14.hangman.v1.BotSkillR\x05skill\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"R\n" +
+	"\x0eAddBotResponse\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12$\n" +
+	"\x04game\x18\x02 \x01(\v2\x10.hangman.v1.GameR\x04game\"\r\n" +
+	"\vPingRequest\"h\n" +
+	"\fPingResponse\x12%\n" +
+	"\x0eserver_version\x18\x01 \x01(\tR\rserverVersion\x121\n" +
+	"\x15server_time_unix_nano\x18\x02 \x01(\x03R\x12serverTimeUnixNano\"\x89\x02\n" +
+	"\tAdminGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1b\n" +
+	"\tplay_word\x18\x02 \x01(\tR\bplayWord\x12#\n" +
+	"\rcomplete_word\x18\x03 \x01(\tR\fcompleteWord\x12\x14\n" +
+	"\x05turns\x18\x05 \x01(\x05R\x05turns\x12\x16\n" +
+	"\x06winner\x18\x06 \x01(\tR\x06winner\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12\x18\n" +
+	"\aplayers\x18\b \x03(\tR\aplayers\x12\x12\n" +
+	"\x04hits\x18\t \x03(\tR\x04hits\x12\x16\n" +
+	"\x06misses\x18\n" +
+	" \x03(\tR\x06missesJ\x04\b\x04\x10\x05R\x0fletters_guessed\"\x15\n" +
+	"\x13ListAllGamesRequest\"C\n" +
+	"\x14ListAllGamesResponse\x12+\n" +
+	"\x05games\x18\x01 \x03(\v2\x15.hangman.v1.AdminGameR\x05games\",\n" +
+	"\x11DeleteGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\"-\n" +
+	"\x12DeleteGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\")\n" +
+	"\x0eEndGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\"<\n" +
+	"\x0fEndGameResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.hangman.v1.AdminGameR\x04game\"+\n" +
+	"\x10ResetGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\">\n" +
+	"\x11ResetGameResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.hangman.v1.AdminGameR\x04game\"F\n" +
+	"\x0fKickUserRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"=\n" +
+	"\x10KickUserResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.hangman.v1.AdminGameR\x04game\"B\n" +
+	"\x0eBanUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05unban\x18\x02 \x01(\bR\x05unban\")\n" +
+	"\x0fBanUserResponse\x12\x16\n" +
+	"\x06banned\x18\x01 \x03(\tR\x06banned\"\x14\n" +
+	"\x12ReloadWordsRequest\"4\n" +
+	"\x13ReloadWordsResponse\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x01 \x01(\x05R\twordCount\".\n" +
+	"\x16SetDefaultTurnsRequest\x12\x14\n" +
+	"\x05turns\x18\x01 \x01(\x05R\x05turns\"V\n" +
+	"\x17SetDefaultTurnsResponse\x12%\n" +
+	"\x0eprevious_turns\x18\x01 \x01(\x05R\rpreviousTurns\x12\x14\n" +
+	"\x05turns\x18\x02 \x01(\x05R\x05turns\"\xa7\x01\n" +
+	"\x05Match\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x18\n" +
+	"\aplayers\x18\x02 \x03(\tR\aplayers\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\x05R\x06gameId\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\x12\x16\n" +
+	"\x06winner\x18\x05 \x01(\tR\x06winner\x12!\n" +
+	"\fgames_played\x18\x06 \x01(\x05R\vgamesPlayed\"\xc2\x02\n" +
+	"\n" +
+	"Tournament\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1c.hangman.v1.TournamentFormatR\x06format\x12\x18\n" +
+	"\aplayers\x18\x04 \x03(\tR\aplayers\x12#\n" +
+	"\rcurrent_round\x18\x05 \x01(\x05R\fcurrentRound\x12!\n" +
+	"\ftotal_rounds\x18\x06 \x01(\x05R\vtotalRounds\x12\x1a\n" +
+	"\bfinished\x18\a \x01(\bR\bfinished\x12\x1a\n" +
+	"\bchampion\x18\b \x01(\tR\bchampion\x12+\n" +
+	"\amatches\x18\t \x03(\v2\x11.hangman.v1.MatchR\amatches\"\xa0\x01\n" +
+	"\bStanding\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06played\x18\x02 \x01(\x05R\x06played\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x14\n" +
+	"\x05draws\x18\x04 \x01(\x05R\x05draws\x12\x16\n" +
+	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x06 \x01(\bR\n" +
+	"eliminated\"\x9f\x01\n" +
+	"\x17CreateTournamentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.hangman.v1.TournamentFormatR\x06format\x12\x18\n" +
+	"\aplayers\x18\x03 \x03(\tR\aplayers\x12\x17\n" +
+	"\x04seed\x18\x04 \x01(\x03H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"R\n" +
+	"\x18CreateTournamentResponse\x126\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x16.hangman.v1.TournamentR\n" +
+	"tournament\"X\n" +
+	"\x15RegisterPlayerRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"P\n" +
+	"\x16RegisterPlayerResponse\x126\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x16.hangman.v1.TournamentR\n" +
+	"tournament\"=\n" +
+	"\x16StartTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\"Q\n" +
+	"\x17StartTournamentResponse\x126\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x16.hangman.v1.TournamentR\n" +
+	"tournament\";\n" +
+	"\x14GetTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\"O\n" +
+	"\x15GetTournamentResponse\x126\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x16.hangman.v1.TournamentR\n" +
+	"tournament\"\x18\n" +
+	"\x16ListTournamentsRequest\"S\n" +
+	"\x17ListTournamentsResponse\x128\n" +
+	"\vtournaments\x18\x01 \x03(\v2\x16.hangman.v1.TournamentR\vtournaments\":\n" +
+	"\x13GetStandingsRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\"J\n" +
+	"\x14GetStandingsResponse\x122\n" +
+	"\tstandings\x18\x01 \x03(\v2\x14.hangman.v1.StandingR\tstandings*k\n" +
+	"\bBotSkill\x12\x19\n" +
+	"\x15BOT_SKILL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10BOT_SKILL_RANDOM\x10\x01\x12\x17\n" +
+	"\x13BOT_SKILL_FREQUENCY\x10\x02\x12\x15\n" +
+	"\x11BOT_SKILL_OPTIMAL\x10\x03*x\n" +
+	"\x10TournamentFormat\x12!\n" +
+	"\x1dTOURNAMENT_FORMAT_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTOURNAMENT_FORMAT_ROUND_ROBIN\x10\x01\x12\x1e\n" +
+	"\x1aTOURNAMENT_FORMAT_KNOCKOUT\x10\x022\xf7\b\n" +
+	"\x0eHangmanService\x12U\n" +
+	"\aNewGame\x12\x1a.hangman.v1.NewGameRequest\x1a\x1b.hangman.v1.NewGameResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/games\x12I\n" +
+	"\x04List\x12\x17.hangman.v1.ListRequest\x1a\x18.hangman.v1.ListResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/games\x12\\\n" +
+	"\aGetGame\x12\x1a.hangman.v1.GetGameRequest\x1a\x1b.hangman.v1.GetGameResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/games/{game_id}\x12a\n" +
+	"\x05Guess\x12\x18.hangman.v1.GuessRequest\x1a\x19.hangman.v1.GuessResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/games/{game_id}/guesses\x12^\n" +
+	"\n" +
+	"CreateRoom\x12\x1d.hangman.v1.CreateRoomRequest\x1a\x1e.hangman.v1.CreateRoomResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/rooms\x12g\n" +
+	"\bJoinRoom\x12\x1b.hangman.v1.JoinRoomRequest\x1a\x1c.hangman.v1.JoinRoomResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/rooms/{code}/players\x12w\n" +
+	"\rSuggestLetter\x12 .hangman.v1.SuggestLetterRequest\x1a!.hangman.v1.SuggestLetterResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/games/{game_id}/hints\x12a\n" +
+	"\x06AddBot\x12\x19.hangman.v1.AddBotRequest\x1a\x1a.hangman.v1.AddBotResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/games/{game_id}/bots\x12K\n" +
+	"\bGameChat\x12\x1b.hangman.v1.GameChatRequest\x1a\x1c.hangman.v1.GameChatResponse\"\x00(\x010\x01\x12`\n" +
+	"\bGetDaily\x12\x1b.hangman.v1.GetDailyRequest\x1a\x1c.hangman.v1.GetDailyResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/daily/{username}\x12q\n" +
+	"\n" +
+	"GuessDaily\x12\x1d.hangman.v1.GuessDailyRequest\x1a\x1e.hangman.v1.GuessDailyResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/daily/{username}/guesses\x12;\n" +
+	"\x04Ping\x12\x17.hangman.v1.PingRequest\x1a\x18.hangman.v1.PingResponse\"\x002\x83\x05\n" +
+	"\fAdminService\x12S\n" +
+	"\fListAllGames\x12\x1f.hangman.v1.ListAllGamesRequest\x1a .hangman.v1.ListAllGamesResponse\"\x00\x12M\n" +
+	"\n" +
+	"DeleteGame\x12\x1d.hangman.v1.DeleteGameRequest\x1a\x1e.hangman.v1.DeleteGameResponse\"\x00\x12D\n" +
+	"\aEndGame\x12\x1a.hangman.v1.EndGameRequest\x1a\x1b.hangman.v1.EndGameResponse\"\x00\x12J\n" +
+	"\tResetGame\x12\x1c.hangman.v1.ResetGameRequest\x1a\x1d.hangman.v1.ResetGameResponse\"\x00\x12G\n" +
+	"\bKickUser\x12\x1b.hangman.v1.KickUserRequest\x1a\x1c.hangman.v1.KickUserResponse\"\x00\x12D\n" +
+	"\aBanUser\x12\x1a.hangman.v1.BanUserRequest\x1a\x1b.hangman.v1.BanUserResponse\"\x00\x12P\n" +
+	"\vReloadWords\x12\x1e.hangman.v1.ReloadWordsRequest\x1a\x1f.hangman.v1.ReloadWordsResponse\"\x00\x12\\\n" +
+	"\x0fSetDefaultTurns\x12\".hangman.v1.SetDefaultTurnsRequest\x1a#.hangman.v1.SetDefaultTurnsResponse\"\x002\xb8\x04\n" +
+	"\x11TournamentService\x12_\n" +
+	"\x10CreateTournament\x12#.hangman.v1.CreateTournamentRequest\x1a$.hangman.v1.CreateTournamentResponse\"\x00\x12Y\n" +
+	"\x0eRegisterPlayer\x12!.hangman.v1.RegisterPlayerRequest\x1a\".hangman.v1.RegisterPlayerResponse\"\x00\x12\\\n" +
+	"\x0fStartTournament\x12\".hangman.v1.StartTournamentRequest\x1a#.hangman.v1.StartTournamentResponse\"\x00\x12V\n" +
+	"\rGetTournament\x12 .hangman.v1.GetTournamentRequest\x1a!.hangman.v1.GetTournamentResponse\"\x00\x12\\\n" +
+	"\x0fListTournaments\x12\".hangman.v1.ListTournamentsRequest\x1a#.hangman.v1.ListTournamentsResponse\"\x00\x12S\n" +
+	"\fGetStandings\x12\x1f.hangman.v1.GetStandingsRequest\x1a .hangman.v1.GetStandingsResponse\"\x00B5Z3github.com/hill399/HangmanGo/hangmanpb/v1;hangmanv1b\x06proto3"
+
+var (
+	file_hangmanpb_v1_hangman_proto_rawDescOnce sync.Once
+	file_hangmanpb_v1_hangman_proto_rawDescData []byte
+)
+
+func file_hangmanpb_v1_hangman_proto_rawDescGZIP() []byte {
+	file_hangmanpb_v1_hangman_proto_rawDescOnce.Do(func() {
+		file_hangmanpb_v1_hangman_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hangmanpb_v1_hangman_proto_rawDesc), len(file_hangmanpb_v1_hangman_proto_rawDesc)))
+	})
+	return file_hangmanpb_v1_hangman_proto_rawDescData
+}
+
+var file_hangmanpb_v1_hangman_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_hangmanpb_v1_hangman_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_hangmanpb_v1_hangman_proto_goTypes = []any{
+	(BotSkill)(0),                    // 0: hangman.v1.BotSkill
+	(TournamentFormat)(0),            // 1: hangman.v1.TournamentFormat
+	(*Game)(nil),                     // 2: hangman.v1.Game
+	(*NewGameRequest)(nil),           // 3: hangman.v1.NewGameRequest
+	(*NewGameResponse)(nil),          // 4: hangman.v1.NewGameResponse
+	(*ListRequest)(nil),              // 5: hangman.v1.ListRequest
+	(*ListResponse)(nil),             // 6: hangman.v1.ListResponse
+	(*GuessRequest)(nil),             // 7: hangman.v1.GuessRequest
+	(*GuessResponse)(nil),            // 8: hangman.v1.GuessResponse
+	(*GetGameRequest)(nil),           // 9: hangman.v1.GetGameRequest
+	(*GetGameResponse)(nil),          // 10: hangman.v1.GetGameResponse
+	(*ChatMessage)(nil),              // 11: hangman.v1.ChatMessage
+	(*GameChatRequest)(nil),          // 12: hangman.v1.GameChatRequest
+	(*GameChatResponse)(nil),         // 13: hangman.v1.GameChatResponse
+	(*GetDailyRequest)(nil),          // 14: hangman.v1.GetDailyRequest
+	(*GetDailyResponse)(nil),         // 15: hangman.v1.GetDailyResponse
+	(*GuessDailyRequest)(nil),        // 16: hangman.v1.GuessDailyRequest
+	(*GuessDailyResponse)(nil),       // 17: hangman.v1.GuessDailyResponse
+	(*CreateRoomRequest)(nil),        // 18: hangman.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),       // 19: hangman.v1.CreateRoomResponse
+	(*JoinRoomRequest)(nil),          // 20: hangman.v1.JoinRoomRequest
+	(*JoinRoomResponse)(nil),         // 21: hangman.v1.JoinRoomResponse
+	(*SuggestLetterRequest)(nil),     // 22: hangman.v1.SuggestLetterRequest
+	(*LetterScore)(nil),              // 23: hangman.v1.LetterScore
+	(*SuggestLetterResponse)(nil),    // 24: hangman.v1.SuggestLetterResponse
+	(*AddBotRequest)(nil),            // 25: hangman.v1.AddBotRequest
+	(*AddBotResponse)(nil),           // 26: hangman.v1.AddBotResponse
+	(*PingRequest)(nil),              // 27: hangman.v1.PingRequest
+	(*PingResponse)(nil),             // 28: hangman.v1.PingResponse
+	(*AdminGame)(nil),                // 29: hangman.v1.AdminGame
+	(*ListAllGamesRequest)(nil),      // 30: hangman.v1.ListAllGamesRequest
+	(*ListAllGamesResponse)(nil),     // 31: hangman.v1.ListAllGamesResponse
+	(*DeleteGameRequest)(nil),        // 32: hangman.v1.DeleteGameRequest
+	(*DeleteGameResponse)(nil),       // 33: hangman.v1.DeleteGameResponse
+	(*EndGameRequest)(nil),           // 34: hangman.v1.EndGameRequest
+	(*EndGameResponse)(nil),          // 35: hangman.v1.EndGameResponse
+	(*ResetGameRequest)(nil),         // 36: hangman.v1.ResetGameRequest
+	(*ResetGameResponse)(nil),        // 37: hangman.v1.ResetGameResponse
+	(*KickUserRequest)(nil),          // 38: hangman.v1.KickUserRequest
+	(*KickUserResponse)(nil),         // 39: hangman.v1.KickUserResponse
+	(*BanUserRequest)(nil),           // 40: hangman.v1.BanUserRequest
+	(*BanUserResponse)(nil),          // 41: hangman.v1.BanUserResponse
+	(*ReloadWordsRequest)(nil),       // 42: hangman.v1.ReloadWordsRequest
+	(*ReloadWordsResponse)(nil),      // 43: hangman.v1.ReloadWordsResponse
+	(*SetDefaultTurnsRequest)(nil),   // 44: hangman.v1.SetDefaultTurnsRequest
+	(*SetDefaultTurnsResponse)(nil),  // 45: hangman.v1.SetDefaultTurnsResponse
+	(*Match)(nil),                    // 46: hangman.v1.Match
+	(*Tournament)(nil),               // 47: hangman.v1.Tournament
+	(*Standing)(nil),                 // 48: hangman.v1.Standing
+	(*CreateTournamentRequest)(nil),  // 49: hangman.v1.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil), // 50: hangman.v1.CreateTournamentResponse
+	(*RegisterPlayerRequest)(nil),    // 51: hangman.v1.RegisterPlayerRequest
+	(*RegisterPlayerResponse)(nil),   // 52: hangman.v1.RegisterPlayerResponse
+	(*StartTournamentRequest)(nil),   // 53: hangman.v1.StartTournamentRequest
+	(*StartTournamentResponse)(nil),  // 54: hangman.v1.StartTournamentResponse
+	(*GetTournamentRequest)(nil),     // 55: hangman.v1.GetTournamentRequest
+	(*GetTournamentResponse)(nil),    // 56: hangman.v1.GetTournamentResponse
+	(*ListTournamentsRequest)(nil),   // 57: hangman.v1.ListTournamentsRequest
+	(*ListTournamentsResponse)(nil),  // 58: hangman.v1.ListTournamentsResponse
+	(*GetStandingsRequest)(nil),      // 59: hangman.v1.GetStandingsRequest
+	(*GetStandingsResponse)(nil),     // 60: hangman.v1.GetStandingsResponse
+}
+var file_hangmanpb_v1_hangman_proto_depIdxs = []int32{
+	2,  // 0: hangman.v1.ListResponse.games:type_name -> hangman.v1.Game
+	2,  // 1: hangman.v1.GuessResponse.game:type_name -> hangman.v1.Game
+	2,  // 2: hangman.v1.GetGameResponse.game:type_name -> hangman.v1.Game
+	11, // 3: hangman.v1.GameChatResponse.message:type_name -> hangman.v1.ChatMessage
+	2,  // 4: hangman.v1.GetDailyResponse.game:type_name -> hangman.v1.Game
+	2,  // 5: hangman.v1.GuessDailyResponse.game:type_name -> hangman.v1.Game
+	2,  // 6: hangman.v1.JoinRoomResponse.game:type_name -> hangman.v1.Game
+	23, // 7: hangman.v1.SuggestLetterResponse.ranked:type_name -> hangman.v1.LetterScore
+	2,  // 8: hangman.v1.SuggestLetterResponse.game:type_name -> hangman.v1.Game
+	0,  // 9: hangman.v1.AddBotRequest.skill:type_name -> hangman.v1.BotSkill
+	2,  // 10: hangman.v1.AddBotResponse.game:type_name -> hangman.v1.Game
+	29, // 11: hangman.v1.ListAllGamesResponse.games:type_name -> hangman.v1.AdminGame
+	29, // 12: hangman.v1.EndGameResponse.game:type_name -> hangman.v1.AdminGame
+	29, // 13: hangman.v1.ResetGameResponse.game:type_name -> hangman.v1.AdminGame
+	29, // 14: hangman.v1.KickUserResponse.game:type_name -> hangman.v1.AdminGame
+	1,  // 15: hangman.v1.Tournament.format:type_name -> hangman.v1.TournamentFormat
+	46, // 16: hangman.v1.Tournament.matches:type_name -> hangman.v1.Match
+	1,  // 17: hangman.v1.CreateTournamentRequest.format:type_name -> hangman.v1.TournamentFormat
+	47, // 18: hangman.v1.CreateTournamentResponse.tournament:type_name -> hangman.v1.Tournament
+	47, // 19: hangman.v1.RegisterPlayerResponse.tournament:type_name -> hangman.v1.Tournament
+	47, // 20: hangman.v1.StartTournamentResponse.tournament:type_name -> hangman.v1.Tournament
+	47, // 21: hangman.v1.GetTournamentResponse.tournament:type_name -> hangman.v1.Tournament
+	47, // 22: hangman.v1.ListTournamentsResponse.tournaments:type_name -> hangman.v1.Tournament
+	48, // 23: hangman.v1.GetStandingsResponse.standings:type_name -> hangman.v1.Standing
+	3,  // 24: hangman.v1.HangmanService.NewGame:input_type -> hangman.v1.NewGameRequest
+	5,  // 25: hangman.v1.HangmanService.List:input_type -> hangman.v1.ListRequest
+	9,  // 26: hangman.v1.HangmanService.GetGame:input_type -> hangman.v1.GetGameRequest
+	7,  // 27: hangman.v1.HangmanService.Guess:input_type -> hangman.v1.GuessRequest
+	18, // 28: hangman.v1.HangmanService.CreateRoom:input_type -> hangman.v1.CreateRoomRequest
+	20, // 29: hangman.v1.HangmanService.JoinRoom:input_type -> hangman.v1.JoinRoomRequest
+	22, // 30: hangman.v1.HangmanService.SuggestLetter:input_type -> hangman.v1.SuggestLetterRequest
+	25, // 31: hangman.v1.HangmanService.AddBot:input_type -> hangman.v1.AddBotRequest
+	12, // 32: hangman.v1.HangmanService.GameChat:input_type -> hangman.v1.GameChatRequest
+	14, // 33: hangman.v1.HangmanService.GetDaily:input_type -> hangman.v1.GetDailyRequest
+	16, // 34: hangman.v1.HangmanService.GuessDaily:input_type -> hangman.v1.GuessDailyRequest
+	27, // 35: hangman.v1.HangmanService.Ping:input_type -> hangman.v1.PingRequest
+	30, // 36: hangman.v1.AdminService.ListAllGames:input_type -> hangman.v1.ListAllGamesRequest
+	32, // 37: hangman.v1.AdminService.DeleteGame:input_type -> hangman.v1.DeleteGameRequest
+	34, // 38: hangman.v1.AdminService.EndGame:input_type -> hangman.v1.EndGameRequest
+	36, // 39: hangman.v1.AdminService.ResetGame:input_type -> hangman.v1.ResetGameRequest
+	38, // 40: hangman.v1.AdminService.KickUser:input_type -> hangman.v1.KickUserRequest
+	40, // 41: hangman.v1.AdminService.BanUser:input_type -> hangman.v1.BanUserRequest
+	42, // 42: hangman.v1.AdminService.ReloadWords:input_type -> hangman.v1.ReloadWordsRequest
+	44, // 43: hangman.v1.AdminService.SetDefaultTurns:input_type -> hangman.v1.SetDefaultTurnsRequest
+	49, // 44: hangman.v1.TournamentService.CreateTournament:input_type -> hangman.v1.CreateTournamentRequest
+	51, // 45: hangman.v1.TournamentService.RegisterPlayer:input_type -> hangman.v1.RegisterPlayerRequest
+	53, // 46: hangman.v1.TournamentService.StartTournament:input_type -> hangman.v1.StartTournamentRequest
+	55, // 47: hangman.v1.TournamentService.GetTournament:input_type -> hangman.v1.GetTournamentRequest
+	57, // 48: hangman.v1.TournamentService.ListTournaments:input_type -> hangman.v1.ListTournamentsRequest
+	59, // 49: hangman.v1.TournamentService.GetStandings:input_type -> hangman.v1.GetStandingsRequest
+	4,  // 50: hangman.v1.HangmanService.NewGame:output_type -> hangman.v1.NewGameResponse
+	6,  // 51: hangman.v1.HangmanService.List:output_type -> hangman.v1.ListResponse
+	10, // 52: hangman.v1.HangmanService.GetGame:output_type -> hangman.v1.GetGameResponse
+	8,  // 53: hangman.v1.HangmanService.Guess:output_type -> hangman.v1.GuessResponse
+	19, // 54: hangman.v1.HangmanService.CreateRoom:output_type -> hangman.v1.CreateRoomResponse
+	21, // 55: hangman.v1.HangmanService.JoinRoom:output_type -> hangman.v1.JoinRoomResponse
+	24, // 56: hangman.v1.HangmanService.SuggestLetter:output_type -> hangman.v1.SuggestLetterResponse
+	26, // 57: hangman.v1.HangmanService.AddBot:output_type -> hangman.v1.AddBotResponse
+	13, // 58: hangman.v1.HangmanService.GameChat:output_type -> hangman.v1.GameChatResponse
+	15, // 59: hangman.v1.HangmanService.GetDaily:output_type -> hangman.v1.GetDailyResponse
+	17, // 60: hangman.v1.HangmanService.GuessDaily:output_type -> hangman.v1.GuessDailyResponse
+	28, // 61: hangman.v1.HangmanService.Ping:output_type -> hangman.v1.PingResponse
+	31, // 62: hangman.v1.AdminService.ListAllGames:output_type -> hangman.v1.ListAllGamesResponse
+	33, // 63: hangman.v1.AdminService.DeleteGame:output_type -> hangman.v1.DeleteGameResponse
+	35, // 64: hangman.v1.AdminService.EndGame:output_type -> hangman.v1.EndGameResponse
+	37, // 65: hangman.v1.AdminService.ResetGame:output_type -> hangman.v1.ResetGameResponse
+	39, // 66: hangman.v1.AdminService.KickUser:output_type -> hangman.v1.KickUserResponse
+	41, // 67: hangman.v1.AdminService.BanUser:output_type -> hangman.v1.BanUserResponse
+	43, // 68: hangman.v1.AdminService.ReloadWords:output_type -> hangman.v1.ReloadWordsResponse
+	45, // 69: hangman.v1.AdminService.SetDefaultTurns:output_type -> hangman.v1.SetDefaultTurnsResponse
+	50, // 70: hangman.v1.TournamentService.CreateTournament:output_type -> hangman.v1.CreateTournamentResponse
+	52, // 71: hangman.v1.TournamentService.RegisterPlayer:output_type -> hangman.v1.RegisterPlayerResponse
+	54, // 72: hangman.v1.TournamentService.StartTournament:output_type -> hangman.v1.StartTournamentResponse
+	56, // 73: hangman.v1.TournamentService.GetTournament:output_type -> hangman.v1.GetTournamentResponse
+	58, // 74: hangman.v1.TournamentService.ListTournaments:output_type -> hangman.v1.ListTournamentsResponse
+	60, // 75: hangman.v1.TournamentService.GetStandings:output_type -> hangman.v1.GetStandingsResponse
+	50, // [50:76] is the sub-list for method output_type
+	24, // [24:50] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_hangmanpb_v1_hangman_proto_init() }
+func file_hangmanpb_v1_hangman_proto_init() {
+	if File_hangmanpb_v1_hangman_proto != nil {
+		return
+	}
+	file_hangmanpb_v1_hangman_proto_msgTypes[1].OneofWrappers = []any{}
+	file_hangmanpb_v1_hangman_proto_msgTypes[16].OneofWrappers = []any{}
+	file_hangmanpb_v1_hangman_proto_msgTypes[47].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hangmanpb_v1_hangman_proto_rawDesc), len(file_hangmanpb_v1_hangman_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   59,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_hangmanpb_v1_hangman_proto_goTypes,
+		DependencyIndexes: file_hangmanpb_v1_hangman_proto_depIdxs,
+		EnumInfos:         file_hangmanpb_v1_hangman_proto_enumTypes,
+		MessageInfos:      file_hangmanpb_v1_hangman_proto_msgTypes,
+	}.Build()
+	File_hangmanpb_v1_hangman_proto = out.File
+	file_hangmanpb_v1_hangman_proto_goTypes = nil
+	file_hangmanpb_v1_hangman_proto_depIdxs = nil
+}
@@ -0,0 +1,9 @@
+package hangmanv1
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI v2 description of the REST/JSON gateway,
+// generated from hangman.proto by protoc-gen-openapiv2.
+//
+//go:embed hangman.swagger.json
+var OpenAPISpec []byte
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+/* Usernames barred from guessing on any game */
+var (
+	bannedMux   sync.RWMutex
+	bannedUsers = make(map[string]bool)
+)
+
+func isBanned(name string) bool {
+	bannedMux.RLock()
+	defer bannedMux.RUnlock()
+
+	return bannedUsers[name]
+}
+
+/* Lists banned usernames in order, for responses and flushing to storage */
+func bannedList() []string {
+	bannedMux.RLock()
+	defer bannedMux.RUnlock()
+
+	return sortedBans()
+}
+
+/* Lists banned usernames in order; callers hold bannedMux */
+func sortedBans() []string {
+	names := make([]string, 0, len(bannedUsers))
+	for name := range bannedUsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/* Replaces the banned usernames with those restored from storage */
+func restoreBans(names []string) {
+	bannedMux.Lock()
+	defer bannedMux.Unlock()
+
+	bannedUsers = make(map[string]bool)
+	for _, name := range names {
+		bannedUsers[name] = true
+	}
+}
+
+/* Rejects AdminService calls which do not carry the configured admin token */
+func adminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/"+hangmanv1.AdminService_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+
+		if token == "" {
+			return nil, status.Error(codes.PermissionDenied, "Admin service disabled, start server with -admin-token")
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		auth := md.Get("authorization")
+		if len(auth) == 0 || subtle.ConstantTimeCompare([]byte(auth[0]), []byte("Bearer "+token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "Invalid admin token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+/* Converts game into its admin view, including the secret word */
+func adminGame(pGame *gameStore) *hangmanv1.AdminGame {
+	return &hangmanv1.AdminGame{
+		GameId:       int32(pGame.gameID),
+		PlayWord:     strings.Join(pGame.playWord, ""),
+		CompleteWord: strings.Join(pGame.completeWord, ""),
+		Hits:         append([]string(nil), pGame.hits...),
+		Misses:       append([]string(nil), pGame.misses...),
+		Turns:        int32(pGame.turns),
+		Winner:       pGame.winner,
+		Active:       pGame.gameState,
+		Players:      append([]string(nil), pGame.players...),
+	}
+}
+
+func (*server) ListAllGames(ctx context.Context, req *hangmanv1.ListAllGamesRequest) (*hangmanv1.ListAllGamesResponse, error) {
+	loggerFrom(ctx).Debug("ListAllGames function was invoked")
+
+	res := &hangmanv1.ListAllGamesResponse{}
+
+	for _, pGame := range sortedGames() {
+		pGame.mux.Lock()
+		res.Games = append(res.Games, adminGame(pGame))
+		pGame.mux.Unlock()
+	}
+
+	return res, nil
+}
+
+func (*server) DeleteGame(ctx context.Context, req *hangmanv1.DeleteGameRequest) (*hangmanv1.DeleteGameResponse, error) {
+	loggerFrom(ctx).Debug("DeleteGame function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	/* A deleted match game can never decide its match, so settle it as a game */
+	/* cut short: a walkover if only one player had guessed, else a draw in a */
+	/* round robin or a replay in a knockout */
+	pGame.mux.Lock()
+	if pGame.gameState {
+		pGame.gameState = false
+		pGame.ended = time.Now()
+		tournamentGameOver(pGame)
+	}
+	pGame.mux.Unlock()
+
+	if !removeGame(int(gameNo)) {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	loggerFrom(ctx).Info("Game deleted", "game_id", gameNo)
+	gameEvents.Publish(gameEvent{GameID: int(gameNo), Deleted: true})
+
+	res := &hangmanv1.DeleteGameResponse{
+		GameId: gameNo,
+	}
+
+	return res, nil
+}
+
+func (*server) EndGame(ctx context.Context, req *hangmanv1.EndGameRequest) (*hangmanv1.EndGameResponse, error) {
+	loggerFrom(ctx).Debug("EndGame function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	if pGame.gameState == false {
+		return nil, status.Errorf(codes.FailedPrecondition, "Game %d is already finished", gameNo)
+	}
+
+	pGame.gameState = false
+	pGame.ended = time.Now()
+	gamesFinished.WithLabelValues("ended").Inc()
+	tournamentGameOver(pGame)
+
+	loggerFrom(ctx).Info("Game force-ended", "game", pGame)
+	gameEvents.Publish(gameEvent{GameID: int(gameNo)})
+
+	res := &hangmanv1.EndGameResponse{
+		Game: adminGame(pGame),
+	}
+
+	return res, nil
+}
+
+func (*server) ResetGame(ctx context.Context, req *hangmanv1.ResetGameRequest) (*hangmanv1.ResetGameResponse, error) {
+	loggerFrom(ctx).Debug("ResetGame function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	word, err := randomWord()
+	if err != nil {
+		return nil, err
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	pGame.Reset(word, int(atomic.LoadInt32(&defaultTurns)), time.Now())
+
+	loggerFrom(ctx).Info("Game reset", "game", pGame)
+	gameEvents.Publish(gameEvent{GameID: int(gameNo)})
+
+	res := &hangmanv1.ResetGameResponse{
+		Game: adminGame(pGame),
+	}
+
+	return res, nil
+}
+
+func (*server) KickUser(ctx context.Context, req *hangmanv1.KickUserRequest) (*hangmanv1.KickUserResponse, error) {
+	loggerFrom(ctx).Debug("KickUser function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+	username := req.GetUsername()
+
+	if username == "" {
+		return nil, status.Error(codes.InvalidArgument, "Username is required")
+	}
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	pGame.Kick(username)
+
+	loggerFrom(ctx).Info("User kicked", "game_id", gameNo, "username", username)
+
+	res := &hangmanv1.KickUserResponse{
+		Game: adminGame(pGame),
+	}
+
+	return res, nil
+}
+
+func (*server) BanUser(ctx context.Context, req *hangmanv1.BanUserRequest) (*hangmanv1.BanUserResponse, error) {
+	loggerFrom(ctx).Debug("BanUser function was invoked", "req", req)
+
+	username := req.GetUsername()
+
+	if username == "" {
+		return nil, status.Error(codes.InvalidArgument, "Username is required")
+	}
+
+	bannedMux.Lock()
+	defer bannedMux.Unlock()
+
+	if req.GetUnban() {
+		delete(bannedUsers, username)
+		loggerFrom(ctx).Info("User unbanned", "username", username)
+	} else {
+		bannedUsers[username] = true
+		loggerFrom(ctx).Info("User banned", "username", username)
+	}
+
+	res := &hangmanv1.BanUserResponse{
+		Banned: sortedBans(),
+	}
+
+	return res, nil
+}
+
+func (*server) ReloadWords(ctx context.Context, req *hangmanv1.ReloadWordsRequest) (*hangmanv1.ReloadWordsResponse, error) {
+	loggerFrom(ctx).Debug("ReloadWords function was invoked")
+
+	n, err := words.Reload()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to reload word list: %v", err)
+	}
+
+	loggerFrom(ctx).Info("Word list reloaded", "word_count", n)
+
+	res := &hangmanv1.ReloadWordsResponse{
+		WordCount: int32(n),
+	}
+
+	return res, nil
+}
+
+func (*server) SetDefaultTurns(ctx context.Context, req *hangmanv1.SetDefaultTurnsRequest) (*hangmanv1.SetDefaultTurnsResponse, error) {
+	loggerFrom(ctx).Debug("SetDefaultTurns function was invoked", "req", req)
+
+	turns := req.GetTurns()
+
+	if turns < 1 || turns > 26 {
+		return nil, status.Errorf(codes.InvalidArgument, "Turns must be between 1 and 26, got %d", turns)
+	}
+
+	res := &hangmanv1.SetDefaultTurnsResponse{
+		PreviousTurns: atomic.SwapInt32(&defaultTurns, turns),
+		Turns:         turns,
+	}
+
+	loggerFrom(ctx).Info("Default turns changed", "previous_turns", res.PreviousTurns, "turns", turns)
+
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBansSurviveRestart(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	ctx := context.Background()
+
+	for _, name := range []string{"mallory", "eve"} {
+		if _, err := srv.BanUser(ctx, &hangmanv1.BanUserRequest{Username: name}); err != nil {
+			t.Fatalf("BanUser(%s): %v", name, err)
+		}
+	}
+
+	store := newFileStorage("", filepath.Join(t.TempDir(), "state.json"))
+	if err := store.SaveState(snapshotState()); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+
+	/* A fresh process starts with nobody banned until its state is restored */
+	restoreBans(nil)
+	state, err := store.LoadState()
+	if err != nil {
+		t.Fatalf("LoadState: %v", err)
+	}
+	restoreState(state)
+
+	if want := []string{"eve", "mallory"}; !reflect.DeepEqual(bannedList(), want) {
+		t.Errorf("Banned after restart = %q, want %q", bannedList(), want)
+	}
+
+	gameNo := newGame("cat")
+	_, err = srv.Guess(ctx, &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: "c", Username: "eve"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Guess by banned user after restart = %v, want PermissionDenied", err)
+	}
+}
+
+func TestReloadWordsReportsMissingList(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("cat\ndog\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fw, err := newFileWordSource(path)
+	if err != nil {
+		t.Fatalf("newFileWordSource: %v", err)
+	}
+	words = fw
+
+	os.Remove(path)
+
+	_, err = srv.ReloadWords(context.Background(), &hangmanv1.ReloadWordsRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReloadWords with the list removed = %v, want FailedPrecondition", err)
+	}
+	if got := fw.Words(); !reflect.DeepEqual(got, []string{"cat", "dog"}) {
+		t.Errorf("Words after failed reload = %q, want the previous list", got)
+	}
+}
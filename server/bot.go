@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Skill levels a bot can play at */
+const (
+	botRandom    = "random"
+	botFrequency = "frequency"
+	botOptimal   = "optimal"
+)
+
+/* Prefix reserved for bot usernames */
+const botPrefix = "bot-"
+
+/* Bots a game may have. They take no seats from a room's player limit */
+const maxBots = 4
+
+/* Pause before each bot takes its turn, so players can follow the game */
+var botDelay = 500 * time.Millisecond
+
+/* Rounds of bot turns in progress, waited for at shutdown so no bot guesses */
+/* after game state is flushed. botsMux orders starting a round against the wait */
+var (
+	botRounds sync.WaitGroup
+	botsMux   sync.Mutex
+)
+
+/* A server-side player in a game */
+type gameBot struct {
+	Name  string `json:"name"`
+	Skill string `json:"skill"`
+}
+
+/* Marks contexts of guesses made by bots */
+type botKey struct{}
+
+/* Refused when a human tries to play under a bot's name */
+var errBotName = status.Errorf(codes.InvalidArgument, "Usernames starting with %q are reserved for bots", botPrefix)
+
+/* Reports whether a username belongs to a bot */
+func isBot(name string) bool {
+	return strings.HasPrefix(name, botPrefix)
+}
+
+func fromBot(ctx context.Context) bool {
+	return ctx.Value(botKey{}) != nil
+}
+
+/* Chooses the bot's next letter from what any player can see of the game */
+func (b gameBot) Choose(pattern, hits, misses []string) string {
+	played := make(map[string]bool)
+	for _, l := range append(append([]string(nil), hits...), misses...) {
+		played[l] = true
+	}
+
+	switch b.Skill {
+	case botOptimal:
+		scores := rankLetters(solverCandidates(words.Words(), pattern, hits, misses), hits, misses)
+		if len(scores) > 0 {
+			return scores[0].letter
+		}
+	case botFrequency:
+		for _, r := range letterFrequency {
+			if !played[string(r)] {
+				return string(r)
+			}
+		}
+	default:
+		var left []string
+		for _, r := range "abcdefghijklmnopqrstuvwxyz" {
+			if !played[string(r)] {
+				left = append(left, string(r))
+			}
+		}
+		if len(left) > 0 {
+			return left[rand.Intn(len(left))]
+		}
+	}
+	return ""
+}
+
+/* Adds a bot to the game under a name unique within it */
+func (pGame *gameStore) AddBot(skill string) string {
+	name := botPrefix + skill
+	for n := 2; pGame.HasBot(name); n++ {
+		name = fmt.Sprintf("%s%s-%d", botPrefix, skill, n)
+	}
+
+	(*pGame).bots = append((*pGame).bots, gameBot{Name: name, Skill: skill})
+	pGame.AddPlayer(name)
+	return name
+}
+
+func (pGame *gameStore) HasBot(name string) bool {
+	for _, b := range (*pGame).bots {
+		if b.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+/* Names of the game's bots */
+func (pGame *gameStore) BotNames() []string {
+	var names []string
+	for _, b := range (*pGame).bots {
+		names = append(names, b.Name)
+	}
+	return names
+}
+
+/* Starts a round of bot turns in the background, unless the server is shutting down */
+func startBots(srv *server, gameID int) {
+	botsMux.Lock()
+	defer botsMux.Unlock()
+
+	if stopping.Err() != nil {
+		return
+	}
+
+	botRounds.Add(1)
+	go func() {
+		defer botRounds.Done()
+		playBots(srv, gameID)
+	}()
+}
+
+/* Waits for bot turns in progress to finish. Once stopping is cancelled no more */
+/* start, and those waiting out botDelay give up their turn */
+func waitBots() {
+	botsMux.Lock()
+	botsMux.Unlock()
+
+	botRounds.Wait()
+}
+
+/* Gives each of the game's bots a turn after a human guess, through the same */
+/* Guess path as any player. A game only runs one round of bot turns at a time */
+func playBots(srv *server, gameID int) {
+	pGame, ok := findGame(gameID)
+	if !ok {
+		return
+	}
+
+	pGame.mux.Lock()
+	if pGame.botsPlaying {
+		pGame.mux.Unlock()
+		return
+	}
+	pGame.botsPlaying = true
+	bots := append([]gameBot(nil), pGame.bots...)
+	pGame.mux.Unlock()
+
+	defer func() {
+		pGame.mux.Lock()
+		pGame.botsPlaying = false
+		pGame.mux.Unlock()
+	}()
+
+	for _, b := range bots {
+		select {
+		case <-time.After(botDelay):
+		case <-stopping.Done():
+			return
+		}
+
+		pGame.mux.Lock()
+		active := pGame.gameState
+		pattern := append([]string(nil), pGame.completeWord...)
+		hits := append([]string(nil), pGame.hits...)
+		misses := append([]string(nil), pGame.misses...)
+		pGame.mux.Unlock()
+
+		if !active {
+			return
+		}
+
+		letter := b.Choose(pattern, hits, misses)
+		if letter == "" {
+			return
+		}
+
+		l := slog.Default().With("bot", b.Name, "skill", b.Skill)
+		ctx := context.WithValue(context.WithValue(context.Background(), botKey{}, b.Name), loggerKey{}, l)
+
+		if _, err := srv.Guess(ctx, &hangmanv1.GuessRequest{GameId: int32(gameID), Letter: letter, Username: b.Name}); err != nil {
+			l.Warn("Bot guess failed", "game_id", gameID, "error", err)
+			return
+		}
+	}
+}
+
+func (*server) AddBot(ctx context.Context, req *hangmanv1.AddBotRequest) (*hangmanv1.AddBotResponse, error) {
+	loggerFrom(ctx).Debug("AddBot function was invoked", "req", req)
+
+	var skill string
+	switch req.GetSkill() {
+	case hangmanv1.BotSkill_BOT_SKILL_RANDOM:
+		skill = botRandom
+	case hangmanv1.BotSkill_BOT_SKILL_FREQUENCY:
+		skill = botFrequency
+	case hangmanv1.BotSkill_BOT_SKILL_OPTIMAL:
+		skill = botOptimal
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Bot skill must be random, frequency or optimal")
+	}
+
+	gameNo := req.GetGameId()
+	username := req.GetUsername()
+
+	switch {
+	case username == "":
+		return nil, status.Error(codes.InvalidArgument, "Username is required")
+	case isBot(username):
+		return nil, errBotName
+	case isBanned(username):
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	}
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	/* Only someone who may play the game may add a bot to it, so outsiders */
+	/* who know a room's game ID cannot fill it with bots */
+	switch {
+	case !pGame.gameState:
+		return nil, status.Errorf(codes.FailedPrecondition, "Game %d is finished", gameNo)
+	case pGame.entrants != nil && pGame.room == nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "Game %d is a tournament match, bots cannot join", gameNo)
+	case pGame.kicked[username]:
+		return nil, status.Errorf(codes.PermissionDenied, "User %s was kicked from Game %d", username, gameNo)
+	case !pGame.MayGuess(username):
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is not playing Game %d", username, gameNo)
+	case len(pGame.bots) >= maxBots:
+		return nil, status.Errorf(codes.ResourceExhausted, "Game %d already has %d bots, the maximum", gameNo, maxBots)
+	}
+
+	name := pGame.AddBot(skill)
+
+	loggerFrom(ctx).Info("Bot added", "game_id", gameNo, "bot", name, "username", username)
+	gameEvents.Publish(gameEvent{GameID: int(gameNo)})
+
+	return &hangmanv1.AddBotResponse{Username: name, Game: playerGame(pGame)}, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOnlyRoomPlayersAddBots(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	ctx := context.Background()
+
+	room, err := srv.CreateRoom(ctx, &hangmanv1.CreateRoomRequest{Host: "alice", Password: "secret", MaxPlayers: 2, Private: true})
+	if err != nil {
+		t.Fatalf("CreateRoom: %v", err)
+	}
+
+	addBot := func(username string) error {
+		_, err := srv.AddBot(ctx, &hangmanv1.AddBotRequest{GameId: room.GameId, Skill: hangmanv1.BotSkill_BOT_SKILL_FREQUENCY, Username: username})
+		return err
+	}
+
+	if err := addBot(""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddBot without a username returned %v, want InvalidArgument", err)
+	}
+	if err := addBot("mallory"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AddBot by an outsider returned %v, want PermissionDenied", err)
+	}
+
+	/* The host fills the bot allowance, which takes no seats from the room */
+	for i := 0; i < maxBots; i++ {
+		if err := addBot("alice"); err != nil {
+			t.Fatalf("AddBot %d by the host: %v", i+1, err)
+		}
+	}
+	if err := addBot("alice"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("AddBot past the maximum returned %v, want ResourceExhausted", err)
+	}
+
+	if _, err := srv.JoinRoom(ctx, &hangmanv1.JoinRoomRequest{Code: room.Code, Username: "bob", Password: "secret"}); err != nil {
+		t.Fatalf("Invitee refused a seat after bots joined: %v", err)
+	}
+
+	/* The bots still take their turns through Guess */
+	pGame, _ := findGame(int(room.GameId))
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	for _, name := range pGame.BotNames() {
+		if !pGame.MayGuess(name) {
+			t.Errorf("Bot %s may not guess", name)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	chatHistoryLen = 50
+	chatMaxLen     = 500
+	chatRate       = rate.Limit(1)
+	chatBurst      = 5
+)
+
+/* Emoji players may react with */
+var chatReactions = map[string]bool{
+	"👍": true, "👎": true, "😂": true, "😮": true, "😢": true, "🎉": true, "❤️": true,
+}
+
+/* Words masked out of chat messages */
+var profanity = regexp.MustCompile(`(?i)\b(damn|hell|crap|shit|fuck\w*|bastard|bitch\w*|ass(hole)?)\b`)
+
+/* Recent messages and per-user rate limits for each game's chat */
+type chatRoom struct {
+	history  []chatMessage
+	limiters map[string]*rate.Limiter
+}
+
+var (
+	chatMux   sync.Mutex
+	chatRooms = make(map[int]*chatRoom)
+)
+
+/* Replaces each letter of a profane word with an asterisk */
+func censor(text string) string {
+	return profanity.ReplaceAllStringFunc(text, func(w string) string {
+		return strings.Repeat("*", utf8.RuneCountInString(w))
+	})
+}
+
+/* Validates, filters and rate limits a message, then records and broadcasts it */
+func postChat(gameID int, username, text, reaction string) (chatMessage, error) {
+	text = strings.TrimSpace(text)
+
+	switch {
+	case username == "":
+		return chatMessage{}, status.Error(codes.InvalidArgument, "Username is required")
+	case isBanned(username):
+		return chatMessage{}, status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	case reaction != "" && !chatReactions[reaction]:
+		return chatMessage{}, status.Errorf(codes.InvalidArgument, "Unsupported reaction %q", reaction)
+	case reaction == "" && text == "":
+		return chatMessage{}, status.Error(codes.InvalidArgument, "Chat message is empty")
+	case utf8.RuneCountInString(text) > chatMaxLen:
+		return chatMessage{}, status.Errorf(codes.InvalidArgument, "Chat message is longer than %d characters", chatMaxLen)
+	}
+
+	if err := mayView(gameID, username); err != nil {
+		return chatMessage{}, err
+	}
+
+	msg := chatMessage{Username: username, Text: censor(text), Reaction: reaction, Time: time.Now()}
+	if reaction != "" {
+		msg.Text = ""
+	}
+
+	chatMux.Lock()
+	room, ok := chatRooms[gameID]
+	if !ok {
+		room = &chatRoom{limiters: make(map[string]*rate.Limiter)}
+		chatRooms[gameID] = room
+	}
+
+	limiter, ok := room.limiters[username]
+	if !ok {
+		limiter = rate.NewLimiter(chatRate, chatBurst)
+		room.limiters[username] = limiter
+	}
+
+	if !limiter.Allow() {
+		chatMux.Unlock()
+		return chatMessage{}, status.Error(codes.ResourceExhausted, "Sending messages too quickly, slow down")
+	}
+
+	room.history = append(room.history, msg)
+	if len(room.history) > chatHistoryLen {
+		room.history = room.history[len(room.history)-chatHistoryLen:]
+	}
+	chatMux.Unlock()
+
+	gameEvents.Publish(gameEvent{GameID: gameID, Chat: &msg})
+	return msg, nil
+}
+
+/* Returns a copy of the game's recent messages, oldest first */
+func chatHistory(gameID int) []chatMessage {
+	chatMux.Lock()
+	defer chatMux.Unlock()
+
+	room, ok := chatRooms[gameID]
+	if !ok {
+		return nil
+	}
+	return append([]chatMessage(nil), room.history...)
+}
+
+/* Drops a game's chat once the game itself is gone */
+func removeChat(gameID int) {
+	chatMux.Lock()
+	delete(chatRooms, gameID)
+	chatMux.Unlock()
+}
+
+func chatProto(gameID int, msg chatMessage) *hangmanv1.ChatMessage {
+	return &hangmanv1.ChatMessage{
+		GameId:       int32(gameID),
+		Username:     msg.Username,
+		Text:         msg.Text,
+		Reaction:     msg.Reaction,
+		SentUnixNano: msg.Time.UnixNano(),
+	}
+}
+
+/* Joins a game's chat: replays history, then relays messages both ways until */
+/* the client closes its side or the game goes away */
+func (*server) GameChat(stream grpc.BidiStreamingServer[hangmanv1.GameChatRequest, hangmanv1.GameChatResponse]) error {
+	ctx := stream.Context()
+
+	join, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	gameID := int(join.GetGameId())
+	username := join.GetUsername()
+
+	if username == "" {
+		return status.Error(codes.InvalidArgument, "Username is required to join chat")
+	}
+	if err := mayView(gameID, username); err != nil {
+		return err
+	}
+
+	loggerFrom(ctx).Info("Chat joined", "game_id", gameID, "username", username)
+
+	/* Subscribe before replaying history so nothing posted in between is missed */
+	ch := gameEvents.Subscribe()
+	defer gameEvents.Unsubscribe(ch)
+
+	for _, msg := range chatHistory(gameID) {
+		if err := stream.Send(&hangmanv1.GameChatResponse{Message: chatProto(gameID, msg)}); err != nil {
+			return err
+		}
+	}
+
+	/* The joining request may already carry a message */
+	if join.GetText() != "" || join.GetReaction() != "" {
+		if _, err := postChat(gameID, username, join.GetText(), join.GetReaction()); err != nil {
+			return err
+		}
+	}
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			if _, err := postChat(gameID, username, req.GetText(), req.GetReaction()); err != nil {
+				/* Rate limited or filtered messages are refused without ending the stream */
+				if status.Code(err) == codes.ResourceExhausted || status.Code(err) == codes.InvalidArgument {
+					loggerFrom(ctx).Debug("Chat message refused", "game_id", gameID, "username", username, "error", err)
+					continue
+				}
+				recvErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-stopping.Done():
+			return status.Error(codes.Unavailable, "Server is shutting down")
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case ev := <-ch:
+			if ev.GameID != gameID {
+				continue
+			}
+			if ev.Deleted {
+				return status.Errorf(codes.NotFound, "Game %d was removed", gameID)
+			}
+			if ev.Chat == nil {
+				continue
+			}
+			if err := stream.Send(&hangmanv1.GameChatResponse{Message: chatProto(gameID, *ev.Chat)}); err != nil {
+				return err
+			}
+		}
+	}
+}
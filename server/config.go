@@ -0,0 +1,336 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+/* Server settings, layered from defaults, then the -config file, then HANGMAN_* */
+/* environment variables, then command-line flags */
+type config struct {
+	Listen          string          `yaml:"listen"`
+	HTTPAddr        string          `yaml:"http_addr"`
+	MetricsAddr     string          `yaml:"metrics_addr"`
+	TLS             tlsFiles        `yaml:"tls"`
+	Storage         storageConfig   `yaml:"storage"`
+	Game            gameConfig      `yaml:"game"`
+	Retention       retentionConfig `yaml:"retention"`
+	RateLimits      rateLimits      `yaml:"rate_limits"`
+	Log             logConfig       `yaml:"log"`
+	AdminToken      string          `yaml:"admin_token"`
+	ShutdownTimeout time.Duration   `yaml:"shutdown_timeout"`
+}
+
+/* Certificate and key served over gRPC and the gateway; both empty serves plaintext */
+type tlsFiles struct {
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+}
+
+func (t tlsFiles) Enabled() bool {
+	return t.Cert != "" || t.Key != ""
+}
+
+type storageConfig struct {
+	/* "file" keeps games in the archive and state files, "memory" keeps nothing across restarts */
+	Backend string `yaml:"backend"`
+	Archive string `yaml:"archive"`
+	State   string `yaml:"state"`
+}
+
+type gameConfig struct {
+	/* Turn budget of new games, which sets their difficulty */
+	Turns     int    `yaml:"turns"`
+	Words     string `yaml:"words"`
+	Seed      int64  `yaml:"seed"`
+	DailySeed string `yaml:"daily_seed"`
+	HintCost  int    `yaml:"hint_cost"`
+}
+
+type retentionConfig struct {
+	IdleTimeout   time.Duration `yaml:"idle_timeout"`
+	Finished      time.Duration `yaml:"finished"`
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+}
+
+type logConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+func defaultConfig() *config {
+	return &config{
+		Listen:          "0.0.0.0:50051",
+		HTTPAddr:        ":8080",
+		MetricsAddr:     ":9090",
+		Storage:         storageConfig{Backend: "file", Archive: "archive.jsonl", State: "state.json"},
+		Game:            gameConfig{Turns: 8},
+		Retention:       retentionConfig{IdleTimeout: 30 * time.Minute, Finished: time.Hour, SweepInterval: time.Minute},
+		RateLimits:      rateLimits{ClientRate: 50, ClientBurst: 100, UserRate: 10, UserBurst: 20, MaxUserGames: 10, MaxGames: 10000},
+		Log:             logConfig{Level: "info", Format: "text"},
+		ShutdownTimeout: 10 * time.Second,
+	}
+}
+
+/* Defines a flag for every setting, bound to cfg and defaulting to its current value */
+func (cfg *config) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cfg.Listen, "listen", cfg.Listen, "address the gRPC server listens on")
+	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "address serving the REST/JSON gateway (empty disables)")
+	fs.StringVar(&cfg.MetricsAddr, "metrics-addr", cfg.MetricsAddr, "address serving Prometheus /metrics over HTTP (empty disables)")
+	fs.StringVar(&cfg.TLS.Cert, "tls-cert", cfg.TLS.Cert, "PEM certificate served over gRPC and the gateway (empty serves plaintext)")
+	fs.StringVar(&cfg.TLS.Key, "tls-key", cfg.TLS.Key, "PEM private key for -tls-cert")
+	fs.StringVar(&cfg.Storage.Backend, "storage", cfg.Storage.Backend, "storage backend: file or memory")
+	fs.StringVar(&cfg.Storage.Archive, "archive", cfg.Storage.Archive, "file finished games are archived to (empty discards them)")
+	fs.StringVar(&cfg.Storage.State, "state", cfg.Storage.State, "file game state is flushed to on shutdown and restored from on start (empty disables)")
+	fs.IntVar(&cfg.Game.Turns, "turns", cfg.Game.Turns, "turn budget given to new games")
+	fs.StringVar(&cfg.Game.Words, "words", cfg.Game.Words, "file of secret words, one per line (default uses the system dictionary)")
+	fs.Int64Var(&cfg.Game.Seed, "seed", cfg.Game.Seed, "seed word choice so the sequence of new game words is reproducible (0 picks randomly)")
+	fs.StringVar(&cfg.Game.DailySeed, "daily-seed", cfg.Game.DailySeed, "secret mixed with the date to choose the daily puzzle word")
+	fs.IntVar(&cfg.Game.HintCost, "hint-cost", cfg.Game.HintCost, "turns deducted from a game for each SuggestLetter hint")
+	fs.DurationVar(&cfg.Retention.IdleTimeout, "idle-timeout", cfg.Retention.IdleTimeout, "forfeit active games with no guesses for this long (0 disables)")
+	fs.DurationVar(&cfg.Retention.Finished, "retention", cfg.Retention.Finished, "keep finished games listed for this long before archiving")
+	fs.DurationVar(&cfg.Retention.SweepInterval, "sweep-interval", cfg.Retention.SweepInterval, "how often the janitor checks for idle and finished games")
+	fs.Float64Var(&cfg.RateLimits.ClientRate, "client-rate", cfg.RateLimits.ClientRate, "calls per second allowed from each client address (0 disables)")
+	fs.IntVar(&cfg.RateLimits.ClientBurst, "client-burst", cfg.RateLimits.ClientBurst, "calls a client may make at once before -client-rate applies")
+	fs.Float64Var(&cfg.RateLimits.UserRate, "user-rate", cfg.RateLimits.UserRate, "calls per second allowed for each username (0 disables)")
+	fs.IntVar(&cfg.RateLimits.UserBurst, "user-burst", cfg.RateLimits.UserBurst, "calls a user may make at once before -user-rate applies")
+	fs.IntVar(&cfg.RateLimits.MaxUserGames, "max-user-games", cfg.RateLimits.MaxUserGames, "active games each user or client may have started (0 disables)")
+	fs.IntVar(&cfg.RateLimits.MaxGames, "max-games", cfg.RateLimits.MaxGames, "active games allowed server-wide (0 disables)")
+	fs.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "minimum log level: debug, info, warn or error")
+	fs.StringVar(&cfg.Log.Format, "log-format", cfg.Log.Format, "log output format: text or json")
+	fs.StringVar(&cfg.AdminToken, "admin-token", cfg.AdminToken, "token required by AdminService calls (empty disables admin)")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "how long to wait for in-flight calls before forcing shutdown")
+}
+
+/* Environment variable overriding a flag, e.g. HANGMAN_LOG_LEVEL for -log-level */
+func envName(flagName string) string {
+	return "HANGMAN_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+/* Builds the configuration from the command line, the file it names and the environment */
+func loadConfig(args []string) (*config, error) {
+	cfg := defaultConfig()
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv(envName("config")), "YAML config file; environment variables and flags override it")
+	cfg.bindFlags(fs)
+
+	/* Parse once to find the config file */
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *path != "" {
+		f, err := os.Open(*path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		*cfg = *defaultConfig()
+		dec := yaml.NewDecoder(f)
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("%s: %v", *path, err)
+		}
+	}
+
+	var errs []error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+		if v, ok := os.LookupEnv(envName(f.Name)); ok {
+			if err := fs.Set(f.Name, v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: invalid value %q: %v", envName(f.Name), v, err))
+			}
+		}
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	/* Parse again so flags given on the command line win over the file and environment */
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return cfg, cfg.Validate()
+}
+
+/* Checks every setting, reporting each problem against its config key and flag */
+func (cfg *config) Validate() error {
+	var errs []error
+	fail := func(key, flagName, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s (-%s): %s", key, flagName, fmt.Sprintf(format, args...)))
+	}
+
+	checkAddr := func(key, flagName, addr string, optional bool) {
+		if addr == "" && optional {
+			return
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			fail(key, flagName, "address %q must be host:port or :port", addr)
+		}
+	}
+	checkAddr("listen", "listen", cfg.Listen, false)
+	checkAddr("http_addr", "http-addr", cfg.HTTPAddr, true)
+	checkAddr("metrics_addr", "metrics-addr", cfg.MetricsAddr, true)
+
+	if cfg.TLS.Enabled() {
+		if cfg.TLS.Cert == "" || cfg.TLS.Key == "" {
+			fail("tls", "tls-cert", "cert and key must be set together")
+		} else if _, err := tls.LoadX509KeyPair(cfg.TLS.Cert, cfg.TLS.Key); err != nil {
+			fail("tls", "tls-cert", "%v", err)
+		}
+	}
+
+	switch cfg.Storage.Backend {
+	case "file", "memory":
+	default:
+		fail("storage.backend", "storage", "unknown backend %q, expected file or memory", cfg.Storage.Backend)
+	}
+
+	if cfg.Game.Turns < 1 || cfg.Game.Turns > 26 {
+		fail("game.turns", "turns", "must be between 1 and 26, got %d", cfg.Game.Turns)
+	}
+	if cfg.Game.HintCost < 0 {
+		fail("game.hint_cost", "hint-cost", "cannot be negative, got %d", cfg.Game.HintCost)
+	}
+	if cfg.Game.Words != "" {
+		if _, err := os.Stat(cfg.Game.Words); err != nil {
+			fail("game.words", "words", "%v", err)
+		}
+	}
+
+	if cfg.Retention.IdleTimeout < 0 {
+		fail("retention.idle_timeout", "idle-timeout", "cannot be negative, got %v", cfg.Retention.IdleTimeout)
+	}
+	if cfg.Retention.Finished < 0 {
+		fail("retention.finished", "retention", "cannot be negative, got %v", cfg.Retention.Finished)
+	}
+	if cfg.Retention.SweepInterval <= 0 {
+		fail("retention.sweep_interval", "sweep-interval", "must be positive, got %v", cfg.Retention.SweepInterval)
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		fail("shutdown_timeout", "shutdown-timeout", "must be positive, got %v", cfg.ShutdownTimeout)
+	}
+
+	if err := cfg.RateLimits.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("rate_limits: %v", err))
+	}
+
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(cfg.Log.Level)); err != nil {
+		fail("log.level", "log-level", "%q is not one of debug, info, warn or error", cfg.Log.Level)
+	}
+	switch strings.ToLower(cfg.Log.Format) {
+	case "text", "json":
+	default:
+		fail("log.format", "log-format", "%q is not one of text or json", cfg.Log.Format)
+	}
+
+	return errors.Join(errs...)
+}
+
+/* Settings which only take effect on restart, keyed by config key */
+func (cfg *config) restartOnly() map[string]interface{} {
+	return map[string]interface{}{
+		"listen":                   cfg.Listen,
+		"http_addr":                cfg.HTTPAddr,
+		"metrics_addr":             cfg.MetricsAddr,
+		"tls":                      cfg.TLS,
+		"storage":                  cfg.Storage,
+		"game.words":               cfg.Game.Words,
+		"game.seed":                cfg.Game.Seed,
+		"game.daily_seed":          cfg.Game.DailySeed,
+		"retention.sweep_interval": cfg.Retention.SweepInterval,
+		"log.format":               cfg.Log.Format,
+		"admin_token":              cfg.AdminToken,
+		"shutdown_timeout":         cfg.ShutdownTimeout,
+	}
+}
+
+/* Carries over the running values of settings which only take effect on restart, */
+/* so later reloads compare against what is actually in use */
+func (cfg *config) keepRestartOnly(old *config) {
+	cfg.Listen, cfg.HTTPAddr, cfg.MetricsAddr = old.Listen, old.HTTPAddr, old.MetricsAddr
+	cfg.TLS, cfg.Storage = old.TLS, old.Storage
+	cfg.Game.Words, cfg.Game.Seed, cfg.Game.DailySeed = old.Game.Words, old.Game.Seed, old.Game.DailySeed
+	cfg.Retention.SweepInterval = old.Retention.SweepInterval
+	cfg.Log.Format, cfg.AdminToken, cfg.ShutdownTimeout = old.Log.Format, old.AdminToken, old.ShutdownTimeout
+}
+
+/* Re-reads the configuration on SIGHUP and applies the settings which can */
+/* change safely while running: log level, turns, hint cost, retention and rate */
+/* limits. The word list is re-read from the same file. An invalid configuration */
+/* is rejected whole, leaving the running one in place */
+func reloadConfig(old *config, args []string, j *janitor) *config {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		slog.Error("Configuration not reloaded", "error", err)
+		return old
+	}
+
+	var applied []string
+
+	if cfg.Log.Level != old.Log.Level {
+		logLevel.UnmarshalText([]byte(cfg.Log.Level))
+		applied = append(applied, "log.level")
+	}
+	/* Only changes are applied, so a turn budget set through AdminService survives reloads */
+	if cfg.Game.Turns != old.Game.Turns {
+		atomic.StoreInt32(&defaultTurns, int32(cfg.Game.Turns))
+		applied = append(applied, "game.turns")
+	}
+	if cfg.Game.HintCost != old.Game.HintCost {
+		atomic.StoreInt32(&hintCost, int32(cfg.Game.HintCost))
+		applied = append(applied, "game.hint_cost")
+	}
+	if cfg.Retention.IdleTimeout != old.Retention.IdleTimeout || cfg.Retention.Finished != old.Retention.Finished {
+		j.SetTimeouts(cfg.Retention.IdleTimeout, cfg.Retention.Finished)
+		applied = append(applied, "retention")
+	}
+	if cfg.RateLimits != old.RateLimits {
+		setRateLimits(cfg.RateLimits)
+		applied = append(applied, "rate_limits")
+	}
+
+	if cfg.Game.Words == old.Game.Words {
+		if n, err := words.Reload(); err != nil {
+			slog.Warn("Failed to reload word list", "error", err)
+		} else {
+			slog.Info("Word list reloaded", "word_count", n)
+		}
+	}
+
+	var ignored []string
+	was := old.restartOnly()
+	for key, v := range cfg.restartOnly() {
+		if !reflect.DeepEqual(v, was[key]) {
+			ignored = append(ignored, key)
+		}
+	}
+	sort.Strings(ignored)
+
+	slog.Info("Configuration reloaded", "applied", applied)
+	if len(ignored) > 0 {
+		slog.Warn("Restart the server to apply changed settings", "settings", ignored)
+	}
+
+	cfg.keepRestartOnly(old)
+	return cfg
+}
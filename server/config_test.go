@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/* Writes a config file into the test's temporary directory, returning its path */
+func writeConfig(t *testing.T, yaml string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "hangman.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestConfigFlagsOverrideEnvOverrideFile(t *testing.T) {
+	path := writeConfig(t, `
+listen: ":7000"
+game:
+  turns: 5
+  hint_cost: 1
+log:
+  level: debug
+`)
+	t.Setenv("HANGMAN_CONFIG", path)
+	t.Setenv("HANGMAN_TURNS", "6")
+	t.Setenv("HANGMAN_LOG_LEVEL", "warn")
+
+	cfg, err := loadConfig([]string{"-turns", "7"})
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+
+	if cfg.Game.Turns != 7 {
+		t.Errorf("Turns = %d, want the flag's 7", cfg.Game.Turns)
+	}
+	if cfg.Log.Level != "warn" {
+		t.Errorf("Log level = %q, want the environment's warn", cfg.Log.Level)
+	}
+	if cfg.Game.HintCost != 1 || cfg.Listen != ":7000" {
+		t.Errorf("Hint cost %d, listen %q, want the file's 1 and :7000", cfg.Game.HintCost, cfg.Listen)
+	}
+	if want := defaultConfig().RateLimits; cfg.RateLimits != want {
+		t.Errorf("Rate limits = %+v, want the defaults %+v", cfg.RateLimits, want)
+	}
+}
+
+func TestConfigFlagNamesFile(t *testing.T) {
+	path := writeConfig(t, "game:\n  turns: 12\n")
+
+	cfg, err := loadConfig([]string{"-config", path})
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.Game.Turns != 12 {
+		t.Errorf("Turns = %d, want the file's 12", cfg.Game.Turns)
+	}
+}
+
+func TestConfigRejectsUnknownKeysAndBadEnv(t *testing.T) {
+	path := writeConfig(t, "turns: 5\n")
+	if _, err := loadConfig([]string{"-config", path}); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("Misplaced key returned %v, want an error naming %s", err, path)
+	}
+
+	t.Setenv("HANGMAN_TURNS", "many")
+	if _, err := loadConfig(nil); err == nil || !strings.Contains(err.Error(), "HANGMAN_TURNS") {
+		t.Errorf("Invalid environment value returned %v, want an error naming HANGMAN_TURNS", err)
+	}
+}
+
+func TestConfigValidateReportsEachProblem(t *testing.T) {
+	if err := defaultConfig().Validate(); err != nil {
+		t.Fatalf("Default config invalid: %v", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.Listen = "nowhere"
+	cfg.TLS.Cert = "cert.pem"
+	cfg.Storage.Backend = "disk"
+	cfg.Game.Turns = 0
+	cfg.Game.HintCost = -1
+	cfg.Retention.SweepInterval = 0
+	cfg.RateLimits.ClientRate = -1
+	cfg.Log.Level = "loud"
+	cfg.Log.Format = "xml"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate accepted an invalid config")
+	}
+
+	for _, want := range []string{
+		"listen (-listen)",
+		"tls (-tls-cert): cert and key must be set together",
+		"storage.backend (-storage)",
+		"game.turns (-turns)",
+		"game.hint_cost (-hint-cost)",
+		"retention.sweep_interval (-sweep-interval)",
+		"rate_limits: rates cannot be negative",
+		"log.level (-log-level)",
+		"log.format (-log-format)",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate error missing %q:\n%v", want, err)
+		}
+	}
+}
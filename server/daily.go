@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Turn budget for the daily puzzle, fixed so every attempt is comparable */
+const dailyTurns = 8
+
+/* Server secret mixed with the date to choose the daily word */
+var dailySeed string
+
+/* Each player's attempt at the daily puzzle, keyed by date and username, */
+/* and the word chosen for each date */
+var (
+	dailyMux   sync.Mutex
+	dailyGames = make(map[string]*gameStore)
+	dailyWords = make(map[string]string)
+)
+
+/* Generates a daily seed for servers not configured with one, so the word */
+/* of the day cannot be predicted from the word list alone */
+func newDailySeed() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+/* Date of the daily puzzle in play at the given time */
+func dailyDate(now time.Time) string {
+	return now.UTC().Format("2006-01-02")
+}
+
+func dailyKey(date, username string) string {
+	return date + "/" + username
+}
+
+/* Chooses the word for a date, the same for every player given the seed and word list. */
+/* The word is kept once chosen, so reloading the word list cannot change a puzzle */
+/* partway through its day. Callers hold dailyMux */
+func dailyWord(date string) (string, error) {
+	if word, ok := dailyWords[date]; ok {
+		return word, nil
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(dailySeed + "|" + date))
+	word, err := words.Nth(h.Sum64())
+	if err != nil {
+		return "", err
+	}
+
+	dailyWords[date] = word
+	return word, nil
+}
+
+/* Returns the player's attempt at the date's puzzle, starting it on first call. */
+/* Attempts at earlier puzzles are dropped once a new day begins */
+func dailyGame(date, username string) (*gameStore, error) {
+	dailyMux.Lock()
+	defer dailyMux.Unlock()
+
+	if pGame, ok := dailyGames[dailyKey(date, username)]; ok {
+		return pGame, nil
+	}
+
+	for key, pGame := range dailyGames {
+		if pGame.daily != date {
+			delete(dailyGames, key)
+		}
+	}
+	for day := range dailyWords {
+		if day != date {
+			delete(dailyWords, day)
+		}
+	}
+
+	word, err := dailyWord(date)
+	if err != nil {
+		return nil, err
+	}
+
+	playWord, completeWord := splitWord(word)
+	now := time.Now()
+
+	pGame := &gameStore{gameState: true, playWord: playWord, completeWord: completeWord, turns: dailyTurns, maxTurns: dailyTurns, winner: "N/A", created: now, lastActivity: now, daily: date}
+	pGame.AddPlayer(username)
+	dailyGames[dailyKey(date, username)] = pGame
+
+	gamesCreated.Inc()
+
+	return pGame, nil
+}
+
+/* Lists held daily attempts in a stable order for flushing to storage */
+func dailyAttempts() []*gameStore {
+	dailyMux.Lock()
+	defer dailyMux.Unlock()
+
+	keys := make([]string, 0, len(dailyGames))
+	for key := range dailyGames {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attempts := make([]*gameStore, 0, len(keys))
+	for _, key := range keys {
+		attempts = append(attempts, dailyGames[key])
+	}
+	return attempts
+}
+
+/* Replaces held daily attempts with those restored from storage */
+func restoreDaily(recs []gameRecord) {
+	dailyMux.Lock()
+	defer dailyMux.Unlock()
+
+	dailyGames = make(map[string]*gameStore)
+	dailyWords = make(map[string]string)
+	for _, rec := range recs {
+		if rec.Daily == "" || len(rec.Players) == 0 {
+			continue
+		}
+		dailyGames[dailyKey(rec.Daily, rec.Players[0])] = gameFromRecord(rec)
+		dailyWords[rec.Daily] = rec.PlayWord
+	}
+}
+
+/* Renders a finished attempt as an emoji grid which gives away nothing but the word length: */
+/* one square per letter revealed or not, then one per turn lost or left */
+func (pGame *gameStore) ShareGrid() string {
+	result := "X"
+	if (*pGame).winner != "N/A" {
+		result = fmt.Sprintf("%d", len((*pGame).misses))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Hangman Daily %s %s/%d\n", (*pGame).daily, result, (*pGame).maxTurns)
+
+	for i := range (*pGame).playWord {
+		if (*pGame).completeWord[i] == (*pGame).playWord[i] {
+			b.WriteString("🟩")
+		} else {
+			b.WriteString("⬜")
+		}
+	}
+	b.WriteString("\n")
+
+	b.WriteString(strings.Repeat("🟥", len((*pGame).misses)))
+	b.WriteString(strings.Repeat("⬛", (*pGame).turns))
+
+	return b.String()
+}
+
+/* Checks a player may take part in the daily puzzle */
+func dailyPlayer(username string) error {
+	if username == "" {
+		return status.Error(codes.InvalidArgument, "Username is required for the daily puzzle")
+	}
+	if isBot(username) {
+		return errBotName
+	}
+	if isBanned(username) {
+		return status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	}
+	return nil
+}
+
+func (*server) GetDaily(ctx context.Context, req *hangmanv1.GetDailyRequest) (*hangmanv1.GetDailyResponse, error) {
+	loggerFrom(ctx).Debug("GetDaily function was invoked", "req", req)
+
+	username := req.GetUsername()
+	if err := dailyPlayer(username); err != nil {
+		return nil, err
+	}
+
+	date := dailyDate(time.Now())
+	pGame, err := dailyGame(date, username)
+	if err != nil {
+		return nil, err
+	}
+
+	pGame.mux.Lock()
+	res := &hangmanv1.GetDailyResponse{
+		Date: date,
+		Game: playerGame(pGame),
+	}
+	if !pGame.gameState {
+		res.Share = pGame.ShareGrid()
+	}
+	pGame.mux.Unlock()
+
+	return res, nil
+}
+
+func (*server) GuessDaily(ctx context.Context, req *hangmanv1.GuessDailyRequest) (*hangmanv1.GuessDailyResponse, error) {
+	loggerFrom(ctx).Debug("GuessDaily function was invoked", "req", req)
+
+	username := req.GetUsername()
+	if err := dailyPlayer(username); err != nil {
+		return nil, err
+	}
+
+	date := dailyDate(time.Now())
+	pGame, err := dailyGame(date, username)
+	if err != nil {
+		return nil, err
+	}
+
+	pGame.mux.Lock()
+	det, err := playGuess(ctx, pGame, username, req.GetLetter())
+	if err != nil {
+		pGame.mux.Unlock()
+		return nil, err
+	}
+
+	res := &hangmanv1.GuessDailyResponse{
+		Date:   date,
+		Game:   playerGame(pGame),
+		Detail: det,
+	}
+	if !pGame.gameState {
+		res.Share = pGame.ShareGrid()
+	}
+	pGame.mux.Unlock()
+
+	return res, nil
+}
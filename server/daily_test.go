@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+)
+
+/* Returns the secret word of a player's attempt at today's puzzle */
+func dailyWordFor(t *testing.T, username string) string {
+	t.Helper()
+
+	if _, err := (&server{}).GetDaily(context.Background(), &hangmanv1.GetDailyRequest{Username: username}); err != nil {
+		t.Fatalf("GetDaily(%s): %v", username, err)
+	}
+
+	pGame, err := dailyGame(dailyDate(time.Now()), username)
+	if err != nil {
+		t.Fatalf("dailyGame(%s): %v", username, err)
+	}
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	return strings.Join(pGame.playWord, "")
+}
+
+func TestDailyWordSurvivesWordListChange(t *testing.T) {
+	resetServer(t)
+	dailySeed = "test"
+
+	first := dailyWordFor(t, "alice")
+
+	/* Every word in the new list differs from the old one */
+	words = sliceWords{"quiz", "jinx", "fjord"}
+
+	if got := dailyWordFor(t, "bob"); got != first {
+		t.Errorf("Daily word changed from %q to %q after the word list changed", first, got)
+	}
+}
+
+func TestDailyWordSurvivesRestart(t *testing.T) {
+	resetServer(t)
+	dailySeed = "test"
+
+	first := dailyWordFor(t, "alice")
+	state := snapshotState()
+
+	restoreState(serverState{})
+	words = sliceWords{"quiz", "jinx", "fjord"}
+	restoreState(state)
+
+	if got := dailyWordFor(t, "bob"); got != first {
+		t.Errorf("Daily word changed from %q to %q across a restart", first, got)
+	}
+	if state.DailySeed != "test" {
+		t.Errorf("State holds daily seed %q, want %q", state.DailySeed, "test")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+/* Chat message or emoji reaction posted to a game */
+type chatMessage struct {
+	Username string    `json:"username"`
+	Text     string    `json:"text,omitempty"`
+	Reaction string    `json:"reaction,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+/* Notification that a game has changed and should be re-read by listeners, */
+/* or that a chat message was posted to it when Chat is set */
+type gameEvent struct {
+	GameID  int
+	Deleted bool
+	Chat    *chatMessage
+}
+
+/* Fans game events out to live subscribers such as web UI streams */
+type eventHub struct {
+	mux  sync.Mutex
+	subs map[chan gameEvent]struct{}
+}
+
+var gameEvents = &eventHub{subs: make(map[chan gameEvent]struct{})}
+
+func (h *eventHub) Subscribe() chan gameEvent {
+	ch := make(chan gameEvent, 16)
+
+	h.mux.Lock()
+	h.subs[ch] = struct{}{}
+	h.mux.Unlock()
+
+	return ch
+}
+
+func (h *eventHub) Unsubscribe(ch chan gameEvent) {
+	h.mux.Lock()
+	delete(h.subs, ch)
+	h.mux.Unlock()
+}
+
+/* Delivers event to every subscriber, dropping it for any too slow to keep up */
+func (h *eventHub) Publish(ev gameEvent) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for ch := range h.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+/* Lists distinct dictionary words the same length as word, the candidates an */
+/* evil game starts from. The word itself is always included */
+func evilCandidates(word string) []string {
+	length := len([]rune(word))
+	seen := map[string]bool{word: true}
+	candidates := []string{word}
+
+	for _, w := range words.Words() {
+		if len([]rune(w)) == length && !seen[w] {
+			seen[w] = true
+			candidates = append(candidates, w)
+		}
+	}
+	return candidates
+}
+
+/* Where a letter appears in a word, as a string of 1s and 0s */
+func letterPattern(word []rune, guess rune) string {
+	var b strings.Builder
+	for _, r := range word {
+		if r == guess {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+/* Narrows an evil game's candidates to the largest family sharing where the */
+/* guessed letter appears, preferring families which reveal fewer letters, */
+/* then swaps the play word for one from that family. Every candidate agrees */
+/* with the letters revealed so far, so the guess is then evaluated as normal */
+func (pGame *gameStore) Narrow(guess string) {
+	g := []rune(guess)
+	if len(g) != 1 {
+		return
+	}
+
+	families := make(map[string][]string)
+	for _, w := range (*pGame).candidates {
+		key := letterPattern([]rune(w), g[0])
+		families[key] = append(families[key], w)
+	}
+
+	keys := make([]string, 0, len(families))
+	for key := range families {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if len(families[a]) != len(families[b]) {
+			return len(families[a]) > len(families[b])
+		}
+		if strings.Count(a, "1") != strings.Count(b, "1") {
+			return strings.Count(a, "1") < strings.Count(b, "1")
+		}
+		return a < b
+	})
+
+	(*pGame).candidates = families[keys[0]]
+	(*pGame).playWord = strings.Split((*pGame).candidates[0], "")
+}
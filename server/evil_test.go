@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+)
+
+func TestNarrowKeepsLargestFamily(t *testing.T) {
+	pGame := &gameStore{candidates: []string{"bell", "belt", "tall", "toll", "tell", "dull"}}
+
+	/* "l" splits them as __ll (tall, toll, tell, dull, bell) and __l_ (belt) */
+	pGame.Narrow("l")
+
+	want := []string{"bell", "tall", "toll", "tell", "dull"}
+	if !reflect.DeepEqual(pGame.candidates, want) {
+		t.Errorf("Candidates = %q, want %q", pGame.candidates, want)
+	}
+	if got := strings.Join(pGame.playWord, ""); got != "bell" {
+		t.Errorf("Play word = %q, want the family's first word bell", got)
+	}
+}
+
+func TestNarrowPrefersFamilyRevealingFewerLetters(t *testing.T) {
+	pGame := &gameStore{candidates: []string{"cast", "mist", "cold", "bold"}}
+
+	/* "s" ties __s_ (cast, mist) with ____ (cold, bold); revealing nothing wins */
+	pGame.Narrow("s")
+
+	want := []string{"cold", "bold"}
+	if !reflect.DeepEqual(pGame.candidates, want) {
+		t.Errorf("Candidates = %q, want %q", pGame.candidates, want)
+	}
+}
+
+func TestEvilGameDodgesGuesses(t *testing.T) {
+	resetServer(t)
+	words = sliceWords{"mast", "mist", "most", "must", "cold"}
+
+	gameNo := newEvilGame("mast")
+	srv := &server{}
+
+	/* "a" appears only in mast, so the game swaps to a word without it */
+	res, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: "a", Username: "alice"})
+	if err != nil {
+		t.Fatalf("Guess: %v", err)
+	}
+	if !reflect.DeepEqual(res.Game.Misses, []string{"a"}) {
+		t.Errorf("Misses = %q, want [a]", res.Game.Misses)
+	}
+
+	pGame, _ := findGame(gameNo)
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	want := []string{"mist", "most", "must", "cold"}
+	if !reflect.DeepEqual(pGame.candidates, want) {
+		t.Errorf("Candidates = %q, want %q", pGame.candidates, want)
+	}
+}
@@ -2,56 +2,145 @@ package main
 
 import (
 	"fmt"
+	"log/slog"
+	"sort"
 	"strings"
 	"sync"
-
-	"github.com/tjarratt/babble"
+	"sync/atomic"
+	"time"
 )
 
 /* type struct to unique game data */
 type gameStore struct {
-	mux            sync.Mutex
-	gameID         int
-	gameState      bool
-	playWord       []string
-	completeWord   []string
-	lettersGuessed []string
-	turns          int
-	winner         string
-}
-
-/* Creates new game and returns game ID */
-func newGame() int {
-	/* Initiate babbler library to use an RWG */
-	babbler := babble.NewBabbler()
-	babbler.Count = 1
-	tempPlayWord := strings.Split(strings.ToLower(babbler.Babble()), "")
+	mux          sync.Mutex
+	gameID       int
+	gameState    bool
+	playWord     []string
+	completeWord []string
+	hits         []string
+	misses       []string
+	turns        int
+	maxTurns     int
+	winner       string
+	players      []string
+	kicked       map[string]bool
+	created      time.Time
+	lastActivity time.Time
+	ended        time.Time
+	daily        string   /* date of the daily puzzle, empty for shared games */
+	entrants     []string /* only these users may guess, when set */
+	room         *room
+	candidates   []string /* words still consistent with every guess in an evil game */
+	bots         []gameBot
+	botsPlaying  bool
+	owner        string /* user or client address which started the game */
+	ownerAddr    string /* client address which started the game */
+}
+
+/* Map to store created games, keyed by game ID */
+var (
+	gamesMux   sync.RWMutex
+	openGames  = make(map[int]*gameStore)
+	nextGameID int
+)
+
+/* Source of secret words and turn budget given to new games */
+var (
+	words        wordSource = &babbleSource{}
+	defaultTurns int32      = 8
+)
+
+/* Splits a word into play letters alongside its blank counterpart */
+func splitWord(word string) ([]string, []string) {
+	tempPlayWord := strings.Split(strings.ToLower(word), "")
 	/* Create blank play word for user to view */
 	var tempCompleteWord []string
 	for range tempPlayWord {
 		tempCompleteWord = append(tempCompleteWord, "_")
 	}
+	return tempPlayWord, tempCompleteWord
+}
+
+/* Creates new game around the given secret word and returns game ID. */
+/* When entrants are given only they may guess */
+func newGame(word string, entrants ...string) int {
+	return createGame(word, nil, entrants, nil)
+}
 
-	/* Generate and push new game in active games array */
-	openGames = append(openGames, gameStore{gameID: len(openGames), gameState: true, playWord: tempPlayWord, completeWord: tempCompleteWord, turns: 8, winner: "N/A"})
+/* Creates new evil game, which keeps every dictionary word the same length as */
+/* word in play for as long as the guesses allow */
+func newEvilGame(word string) int {
+	return createGame(word, nil, nil, evilCandidates(strings.ToLower(word)))
+}
+
+/* Creates new game hosted as a room, which only its host may guess until others join */
+func newRoom(word string, r *room) int {
+	return createGame(word, r, []string{r.Host}, nil)
+}
+
+func createGame(word string, r *room, entrants, candidates []string) int {
+	tempPlayWord, tempCompleteWord := splitWord(word)
+
+	now := time.Now()
+	turns := int(atomic.LoadInt32(&defaultTurns))
 
-	/* Print status to server console */
-	fmt.Printf("Game %d Created:\n", len(openGames)-1)
-	fmt.Println(openGames[len(openGames)-1])
+	/* Generate and push new game into active games map */
+	gamesMux.Lock()
+	pGame := &gameStore{gameID: nextGameID, gameState: true, playWord: tempPlayWord, completeWord: tempCompleteWord, turns: turns, maxTurns: turns, winner: "N/A", created: now, lastActivity: now, entrants: entrants, room: r, candidates: candidates}
+	openGames[pGame.gameID] = pGame
+	nextGameID++
+	gamesMux.Unlock()
+
+	gamesCreated.Inc()
+	wordLength.Observe(float64(len(tempPlayWord)))
+
+	slog.Debug("Game created", "game_id", pGame.gameID, "word_length", len(tempPlayWord), "turns", turns)
 
 	/* return game ID */
-	return openGames[len(openGames)-1].gameID
+	return pGame.gameID
 }
 
-func (pGame *gameStore) PrintGame() string {
+/* Looks up a game by ID, reporting whether it exists */
+func findGame(id int) (*gameStore, bool) {
+	gamesMux.RLock()
+	defer gamesMux.RUnlock()
 
-	return fmt.Sprintf("   %d	   %s       %t       %d      %s\n",
-		(*pGame).gameID,
-		(*pGame).winner,
-		(*pGame).gameState,
-		(*pGame).turns,
-		strings.Join((*pGame).completeWord, ","),
-	)
+	pGame, ok := openGames[id]
+	return pGame, ok
+}
+
+/* Returns a snapshot of all held games ordered by game ID */
+func sortedGames() []*gameStore {
+	gamesMux.RLock()
+	games := make([]*gameStore, 0, len(openGames))
+	for _, pGame := range openGames {
+		games = append(games, pGame)
+	}
+	gamesMux.RUnlock()
+
+	sort.Slice(games, func(i, j int) bool { return games[i].gameID < games[j].gameID })
+	return games
+}
+
+/* Removes a game from the active games map, reporting whether it existed */
+func removeGame(id int) bool {
+	gamesMux.Lock()
+	defer gamesMux.Unlock()
+
+	if _, ok := openGames[id]; !ok {
+		return false
+	}
+	delete(openGames, id)
+	removeChat(id)
+	return true
+}
+
+/* Names the game in messages to players */
+func (pGame *gameStore) Name() string {
+	if (*pGame).daily != "" {
+		return "the " + (*pGame).daily + " daily puzzle"
+	}
+	return fmt.Sprintf("Game %d", (*pGame).gameID)
 }
 
 func (pGame *gameStore) IsGameActive(d *[]string) {
@@ -60,23 +149,28 @@ func (pGame *gameStore) IsGameActive(d *[]string) {
 	}
 }
 
-/* Evaluates user guess against array of characters already used in active game */
+/* Evaluates user guess against the hits and misses already played in active game */
 func (pGame *gameStore) IsLetterValid(guess string, d *[]string) bool {
-	for _, letter := range (*pGame).lettersGuessed {
-		if letter == guess {
-			/* Add guessed letter to burnt letters array */
-			*d = append(*d, fmt.Sprintf("Letter already played, try again\n"))
-			return false
+	for _, played := range [][]string{(*pGame).hits, (*pGame).misses} {
+		for _, letter := range played {
+			if letter == guess {
+				*d = append(*d, fmt.Sprintf("Letter already played, try again\n"))
+				return false
+			}
 		}
 	}
 
-	(*pGame).lettersGuessed = append((*pGame).lettersGuessed, guess)
 	return true
 }
 
 func (pGame *gameStore) EvaluateGuess(guess string, d *[]string) {
 	var ls int
 
+	/* An evil game only commits to letters once every remaining word has them */
+	if (*pGame).candidates != nil {
+		pGame.Narrow(guess)
+	}
+
 	for i := range (*pGame).playWord {
 		if (*pGame).playWord[i] == guess {
 			(*pGame).completeWord[i] = (*pGame).playWord[i]
@@ -86,8 +180,14 @@ func (pGame *gameStore) EvaluateGuess(guess string, d *[]string) {
 
 	*d = append(*d, fmt.Sprintf("%d Correct letters found!\n", ls))
 
+	/* Add guessed letter to the burnt hits or misses */
+	if ls > 0 {
+		(*pGame).hits = append((*pGame).hits, guess)
+	}
+
 	/* If char not found, reduce number of turns */
 	if ls == 0 {
+		(*pGame).misses = append((*pGame).misses, guess)
 		(*pGame).turns = (*pGame).turns - 1
 		if (*pGame).turns == 0 {
 			(*pGame).gameState = false
@@ -111,3 +211,173 @@ func (pGame *gameStore) EvaluateWinState(name string) {
 		(*pGame).gameState = false
 	}
 }
+
+/* Ends a game which has seen no guesses within the inactivity timeout */
+func (pGame *gameStore) Forfeit(now time.Time) {
+	(*pGame).gameState = false
+	(*pGame).winner = "Forfeit"
+	(*pGame).ended = now
+}
+
+/* Restarts a game with a fresh word and turn budget, clearing all progress */
+func (pGame *gameStore) Reset(word string, turns int, now time.Time) {
+	(*pGame).playWord, (*pGame).completeWord = splitWord(word)
+	if (*pGame).candidates != nil {
+		(*pGame).candidates = evilCandidates(strings.ToLower(word))
+	}
+	(*pGame).hits = nil
+	(*pGame).misses = nil
+	(*pGame).turns = turns
+	(*pGame).maxTurns = turns
+	(*pGame).winner = "N/A"
+	(*pGame).gameState = true
+	(*pGame).lastActivity = now
+	(*pGame).ended = time.Time{}
+}
+
+/* Reports whether a user may guess, which is anyone unless the game has entrants. */
+/* The game's own bots may always guess */
+func (pGame *gameStore) MayGuess(name string) bool {
+	if (*pGame).entrants == nil || pGame.HasBot(name) {
+		return true
+	}
+	for _, entrant := range (*pGame).entrants {
+		if entrant == name {
+			return true
+		}
+	}
+	return false
+}
+
+/* Records a username as having played the game */
+func (pGame *gameStore) AddPlayer(name string) {
+	for _, player := range (*pGame).players {
+		if player == name {
+			return
+		}
+	}
+	(*pGame).players = append((*pGame).players, name)
+}
+
+/* Removes a player from the game and blocks them from guessing again */
+func (pGame *gameStore) Kick(name string) {
+	for i, player := range (*pGame).players {
+		if player == name {
+			(*pGame).players = append((*pGame).players[:i], (*pGame).players[i+1:]...)
+			break
+		}
+	}
+
+	if (*pGame).kicked == nil {
+		(*pGame).kicked = make(map[string]bool)
+	}
+	(*pGame).kicked[name] = true
+}
+
+/* Converts game into a record suitable for persistent storage */
+func (pGame *gameStore) Record() gameRecord {
+	rec := gameRecord{
+		GameID:       (*pGame).gameID,
+		Active:       (*pGame).gameState,
+		PlayWord:     strings.Join((*pGame).playWord, ""),
+		CompleteWord: strings.Join((*pGame).completeWord, ""),
+		Hits:         append([]string(nil), (*pGame).hits...),
+		Misses:       append([]string(nil), (*pGame).misses...),
+		Turns:        (*pGame).turns,
+		MaxTurns:     (*pGame).maxTurns,
+		Winner:       (*pGame).winner,
+		Players:      append([]string(nil), (*pGame).players...),
+		Created:      (*pGame).created,
+		LastActivity: (*pGame).lastActivity,
+		Ended:        (*pGame).ended,
+		Daily:        (*pGame).daily,
+		Entrants:     append([]string(nil), (*pGame).entrants...),
+		Room:         (*pGame).room,
+		Candidates:   append([]string(nil), (*pGame).candidates...),
+		Bots:         append([]gameBot(nil), (*pGame).bots...),
+		Owner:        (*pGame).owner,
+		OwnerAddr:    (*pGame).ownerAddr,
+	}
+
+	for name := range (*pGame).kicked {
+		rec.Kicked = append(rec.Kicked, name)
+	}
+	sort.Strings(rec.Kicked)
+
+	return rec
+}
+
+/* Rebuilds a game from its stored record */
+func gameFromRecord(rec gameRecord) *gameStore {
+	pGame := &gameStore{
+		gameID:       rec.GameID,
+		gameState:    rec.Active,
+		playWord:     strings.Split(rec.PlayWord, ""),
+		completeWord: strings.Split(rec.CompleteWord, ""),
+		hits:         rec.Hits,
+		misses:       rec.Misses,
+		turns:        rec.Turns,
+		maxTurns:     rec.MaxTurns,
+		winner:       rec.Winner,
+		players:      rec.Players,
+		created:      rec.Created,
+		lastActivity: rec.LastActivity,
+		ended:        rec.Ended,
+		daily:        rec.Daily,
+		entrants:     rec.Entrants,
+		room:         rec.Room,
+		candidates:   rec.Candidates,
+		bots:         rec.Bots,
+		owner:        rec.Owner,
+		ownerAddr:    rec.OwnerAddr,
+	}
+
+	for _, name := range rec.Kicked {
+		pGame.Kick(name)
+	}
+
+	return pGame
+}
+
+/* Snapshots every held game for flushing to storage */
+func snapshotState() serverState {
+	gamesMux.RLock()
+	state := serverState{NextGameID: nextGameID, DailySeed: dailySeed}
+	gamesMux.RUnlock()
+
+	state.Games = []gameRecord{}
+	for _, pGame := range sortedGames() {
+		pGame.mux.Lock()
+		state.Games = append(state.Games, pGame.Record())
+		pGame.mux.Unlock()
+	}
+
+	for _, pGame := range dailyAttempts() {
+		pGame.mux.Lock()
+		state.Daily = append(state.Daily, pGame.Record())
+		pGame.mux.Unlock()
+	}
+
+	state.NextTournamentID, state.Tournaments = snapshotTournaments()
+	state.Banned = bannedList()
+	return state
+}
+
+/* Replaces held games with those restored from storage */
+func restoreState(state serverState) {
+	gamesMux.Lock()
+	defer gamesMux.Unlock()
+
+	openGames = make(map[int]*gameStore)
+	nextGameID = state.NextGameID
+	for _, rec := range state.Games {
+		openGames[rec.GameID] = gameFromRecord(rec)
+		if rec.GameID >= nextGameID {
+			nextGameID = rec.GameID + 1
+		}
+	}
+
+	restoreDaily(state.Daily)
+	restoreTournaments(state.NextTournamentID, state.Tournaments)
+	restoreBans(state.Banned)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+/* Builds the REST/JSON gateway, proxying onto the gRPC server at grpcAddr */
+/* so requests pass through the same interceptors as native gRPC calls. */
+/* The web UI, its event stream and the WebSocket channel share the address. */
+func newGateway(ctx context.Context, grpcAddr string, creds credentials.TransportCredentials) (http.Handler, error) {
+	cc, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	client := hangmanv1.NewHangmanServiceClient(cc)
+
+	gw := runtime.NewServeMux()
+	if err := hangmanv1.RegisterHangmanServiceHandlerClient(ctx, gw, client); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/games", gw)
+	mux.Handle("/games/", gw)
+	mux.Handle("/daily/", gw)
+	mux.Handle("/rooms", gw)
+	mux.Handle("/rooms/", gw)
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(hangmanv1.OpenAPISpec)
+	})
+	mux.HandleFunc("/events", serveEvents)
+	mux.Handle("/ws", &wsHandler{client: client})
+	mux.Handle("/", webHandler())
+
+	return mux, nil
+}
@@ -1,3 +1,30 @@
 module github.com/hill399/HangmanGo/server
 
-go 1.13
+go 1.24.0
+
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
+	github.com/hill399/HangmanGo/hangmanpb v0.0.0
+	github.com/prometheus/client_golang v1.19.1
+	github.com/tjarratt/babble v0.0.0-20191209142150-eecdf8c2339d
+	golang.org/x/time v0.5.0
+	google.golang.org/grpc v1.75.1
+	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/prometheus/client_model v0.5.0 // indirect
+	github.com/prometheus/common v0.48.0 // indirect
+	github.com/prometheus/procfs v0.12.0 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.29.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20250929231259-57b25ae835d4 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250929231259-57b25ae835d4 // indirect
+)
+
+replace github.com/hill399/HangmanGo/hangmanpb => ../hangmanpb
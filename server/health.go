@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/hill399/HangmanGo/hangmanpb"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+/* Server version, overridden at build time with -ldflags "-X main.version=..." */
+var version = "dev"
+
+/* Game services reported individually by the health service */
+var healthServices = []string{
+	hangmanv1.HangmanService_ServiceDesc.ServiceName,
+	hangmanv1.TournamentService_ServiceDesc.ServiceName,
+	hangmanpb.GuessService_ServiceDesc.ServiceName,
+	hangmanpb.NewGameService_ServiceDesc.ServiceName,
+	hangmanpb.ListService_ServiceDesc.ServiceName,
+}
+
+/* Registers grpc.health.v1 and server reflection, marking all game services as serving */
+func registerHealth(s *grpc.Server) *health.Server {
+	hs := health.NewServer()
+	for _, name := range healthServices {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, hs)
+
+	reflection.Register(s)
+
+	return hs
+}
+
+func (*server) Ping(ctx context.Context, req *hangmanv1.PingRequest) (*hangmanv1.PingResponse, error) {
+	res := &hangmanv1.PingResponse{
+		ServerVersion:      version,
+		ServerTimeUnixNano: time.Now().UnixNano(),
+	}
+
+	return res, nil
+}
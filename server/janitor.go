@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+/* Background worker which forfeits idle games and archives finished ones */
+type janitor struct {
+	mux         sync.Mutex
+	interval    time.Duration
+	idleTimeout time.Duration
+	retention   time.Duration
+	store       gameStorage
+}
+
+/* Sweeps open games every interval until the context is cancelled */
+func (j *janitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			j.sweep(now)
+		}
+	}
+}
+
+/* Changes the inactivity timeout and retention period from the next sweep */
+func (j *janitor) SetTimeouts(idleTimeout, retention time.Duration) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	j.idleTimeout, j.retention = idleTimeout, retention
+}
+
+func (j *janitor) sweep(now time.Time) {
+	j.mux.Lock()
+	idleTimeout, retention := j.idleTimeout, j.retention
+	j.mux.Unlock()
+
+	/* Idle buckets have refilled, so forgetting them changes nothing */
+	clientBuckets.Prune(now.Add(-j.interval))
+	userBuckets.Prune(now.Add(-j.interval))
+
+	for _, pGame := range sortedGames() {
+		pGame.mux.Lock()
+
+		/* Forfeit active games which have not seen a guess within the timeout */
+		if pGame.gameState == true && idleTimeout > 0 && now.Sub(pGame.lastActivity) > idleTimeout {
+			pGame.Forfeit(now)
+			gamesFinished.WithLabelValues("forfeit").Inc()
+			tournamentGameOver(pGame)
+			gameEvents.Publish(gameEvent{GameID: pGame.gameID})
+			slog.Info("Game forfeited after inactivity", "game", pGame, "idle_timeout", idleTimeout)
+		}
+
+		/* Archive finished games once they have outlived the retention period */
+		expired := pGame.gameState == false && now.Sub(pGame.ended) > retention
+		var rec gameRecord
+		if expired {
+			rec = pGame.Record()
+		}
+
+		pGame.mux.Unlock()
+
+		if !expired {
+			continue
+		}
+
+		if err := j.store.Archive(rec); err != nil {
+			slog.Error("Failed to archive game", "game_id", rec.GameID, "error", err)
+			continue
+		}
+
+		removeGame(rec.GameID)
+		gameEvents.Publish(gameEvent{GameID: rec.GameID, Deleted: true})
+		slog.Info("Game archived", "game_id", rec.GameID)
+	}
+}
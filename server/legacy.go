@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hill399/HangmanGo/hangmanpb"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+)
+
+/* Serves the deprecated single-RPC services by translating onto hangman.v1 */
+type legacyServer struct {
+	hangmanpb.UnimplementedGuessServiceServer
+	hangmanpb.UnimplementedNewGameServiceServer
+	hangmanpb.UnimplementedListServiceServer
+
+	v1 hangmanv1.HangmanServiceServer
+}
+
+/* Formats a game as the row printed by pre-v1 clients */
+func legacyGameLine(g *hangmanv1.Game) string {
+	letters := make([]string, 0, len(g.WordState))
+	for _, l := range g.WordState {
+		letters = append(letters, string(l))
+	}
+
+	return fmt.Sprintf("   %d	   %s       %t       %d      %s\n",
+		g.GameId,
+		g.Winner,
+		g.Active,
+		g.Turns,
+		strings.Join(letters, ","),
+	)
+}
+
+func (ls *legacyServer) Guess(ctx context.Context, req *hangmanpb.GuessRequest) (*hangmanpb.GuessResponse, error) {
+	res, err := ls.v1.Guess(ctx, &hangmanv1.GuessRequest{
+		GameId:   req.GetGuess().GetGameNumber(),
+		Letter:   req.GetGuess().GetGuessLetter(),
+		Username: req.GetGuess().GetUsername(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hangmanpb.GuessResponse{
+		Response: legacyGameLine(res.Game),
+		Detail:   res.Detail,
+	}, nil
+}
+
+func (ls *legacyServer) NewGame(ctx context.Context, req *hangmanpb.NewGameRequest) (*hangmanpb.NewGameResponse, error) {
+	res, err := ls.v1.NewGame(ctx, &hangmanv1.NewGameRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hangmanpb.NewGameResponse{
+		GameNumber: res.GameId,
+	}, nil
+}
+
+func (ls *legacyServer) List(ctx context.Context, req *hangmanpb.ListRequest) (*hangmanpb.ListResponse, error) {
+	res, err := ls.v1.List(ctx, &hangmanv1.ListRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	sa := []string{"\nGAME ID| WINNER | PLAYABLE | TURNS | WORD STATE\n"}
+	for _, g := range res.Games {
+		sa = append(sa, legacyGameLine(g))
+	}
+
+	return &hangmanpb.ListResponse{
+		GameDetails: sa,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type loggerKey struct{}
+
+/* Minimum level logged, adjustable while running */
+var logLevel slog.LevelVar
+
+/* Builds the server logger from the -log-level and -log-format options */
+func newLogger(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q, expected debug, info, warn or error", level)
+	}
+
+	logLevel.Set(lvl)
+	opts := &slog.HandlerOptions{Level: &logLevel}
+
+	switch strings.ToLower(format) {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q, expected text or json", format)
+	}
+}
+
+/* Returns the request-scoped logger, falling back to the default logger */
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+/* Generates a short random ID used to correlate log lines for one request */
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+/* Returns a logger tagged with the caller's request ID, generating one if */
+/* absent, and echoes the ID back in the response header */
+func requestLogger(ctx context.Context, method string) *slog.Logger {
+	/* Reuse caller supplied request ID where present */
+	reqID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("x-request-id"); len(ids) > 0 {
+			reqID = ids[0]
+		}
+	}
+	if reqID == "" {
+		reqID = newRequestID()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs("x-request-id", reqID))
+
+	return slog.Default().With("request_id", reqID, "method", method)
+}
+
+/* Logs how a call or stream completed */
+func logOutcome(l *slog.Logger, start time.Time, err error) {
+	code := status.Code(err).String()
+	if err != nil {
+		l.Warn("Request failed", "code", code, "duration", time.Since(start), "error", err)
+	} else {
+		l.Info("Request handled", "code", code, "duration", time.Since(start))
+	}
+}
+
+/* Tags each call with a request ID, exposes it to handlers and logs the outcome */
+func requestLogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	l := requestLogger(ctx, info.FullMethod)
+	ctx = context.WithValue(ctx, loggerKey{}, l)
+
+	start := time.Now()
+	res, err := handler(ctx, req)
+	logOutcome(l, start, err)
+
+	return res, err
+}
+
+/* Server stream carrying a replacement context */
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (cs *contextStream) Context() context.Context {
+	return cs.ctx
+}
+
+/* Streaming counterpart of requestLogInterceptor */
+func requestLogStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	l := requestLogger(ss.Context(), info.FullMethod)
+	ctx := context.WithValue(ss.Context(), loggerKey{}, l)
+
+	start := time.Now()
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	logOutcome(l, start, err)
+
+	return err
+}
+
+/* Logs game state without the secret play word */
+func (pGame *gameStore) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("game_id", pGame.gameID),
+		slog.Bool("active", pGame.gameState),
+		slog.Int("turns", pGame.turns),
+		slog.String("winner", pGame.winner),
+		slog.String("word_state", strings.Join(pGame.completeWord, "")),
+		slog.String("hits", strings.Join(pGame.hits, "")),
+		slog.String("misses", strings.Join(pGame.misses, "")),
+		slog.String("play_word", "[REDACTED]"),
+	)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+/* Prometheus metrics exposed on the /metrics endpoint */
+var (
+	gamesCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hangman_games_created_total",
+		Help: "Number of games created.",
+	})
+
+	guessesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hangman_guesses_total",
+		Help: "Number of guesses received, by outcome (hit, miss, repeat, inactive, invalid).",
+	}, []string{"outcome"})
+
+	hintsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hangman_hints_total",
+		Help: "Number of letters suggested by the solver.",
+	})
+
+	rateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hangman_rate_limited_total",
+		Help: "Number of calls rejected by rate limits and game caps, by limit (client, user, user_games, games).",
+	}, []string{"limit"})
+
+	gamesFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "hangman_games_finished_total",
+		Help: "Number of games finished, by result (win, loss, forfeit, ended).",
+	}, []string{"result"})
+
+	wordLength = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "hangman_word_length",
+		Help:    "Length of secret words given to new games.",
+		Buckets: prometheus.LinearBuckets(2, 2, 10),
+	})
+
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "hangman_rpc_duration_seconds",
+		Help:    "Latency of gRPC calls and lifetime of streams, by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "hangman_active_games",
+		Help: "Number of games currently accepting guesses.",
+	}, func() float64 {
+		var n int
+		for _, pGame := range sortedGames() {
+			pGame.mux.Lock()
+			if pGame.gameState == true {
+				n++
+			}
+			pGame.mux.Unlock()
+		}
+		return float64(n)
+	})
+)
+
+/* Records the latency and status code of each unary call */
+func metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	rpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+/* Records the lifetime and status code of each stream */
+func metricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	rpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return err
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+/* Limits on call rates and active games. A zero rate or cap disables that limit */
+type rateLimits struct {
+	/* Calls per second, and burst, allowed from each client address */
+	ClientRate  float64 `yaml:"client_rate"`
+	ClientBurst int     `yaml:"client_burst"`
+	/* Calls per second, and burst, allowed for each username named in a request */
+	UserRate  float64 `yaml:"user_rate"`
+	UserBurst int     `yaml:"user_burst"`
+	/* Active games each user or client address may have started, and active games server-wide */
+	MaxUserGames int `yaml:"max_user_games"`
+	MaxGames     int `yaml:"max_games"`
+}
+
+/* Rejects negative rates and caps */
+func (l rateLimits) Validate() error {
+	switch {
+	case l.ClientRate < 0 || l.UserRate < 0:
+		return errors.New("rates cannot be negative")
+	case l.ClientBurst < 0 || l.UserBurst < 0:
+		return errors.New("bursts cannot be negative")
+	case l.MaxUserGames < 0 || l.MaxGames < 0:
+		return errors.New("game caps cannot be negative")
+	}
+	return nil
+}
+
+var (
+	limitsMux sync.RWMutex
+	limits    rateLimits
+)
+
+/* Replaces the limits in force; buckets pick up new rates on their next call */
+func setRateLimits(l rateLimits) {
+	limitsMux.Lock()
+	defer limitsMux.Unlock()
+
+	limits = l
+}
+
+func currentRateLimits() rateLimits {
+	limitsMux.RLock()
+	defer limitsMux.RUnlock()
+
+	return limits
+}
+
+/* A token bucket and when it was last drawn from */
+type bucket struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+/* Token buckets keyed by client address or username */
+type bucketSet struct {
+	mux     sync.Mutex
+	buckets map[string]*bucket
+}
+
+var (
+	clientBuckets = &bucketSet{buckets: make(map[string]*bucket)}
+	userBuckets   = &bucketSet{buckets: make(map[string]*bucket)}
+)
+
+/* Takes a token from the key's bucket, returning how long to wait when it is empty */
+func (bs *bucketSet) Take(key string, r float64, burst int, now time.Time) time.Duration {
+	if r <= 0 {
+		return 0
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+
+	b, ok := bs.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(r), burst)}
+		bs.buckets[key] = b
+	}
+	b.seen = now
+
+	/* Follow limits changed since the bucket was made */
+	if b.limiter.Limit() != rate.Limit(r) {
+		b.limiter.SetLimitAt(now, rate.Limit(r))
+	}
+	if b.limiter.Burst() != burst {
+		b.limiter.SetBurstAt(now, burst)
+	}
+
+	res := b.limiter.ReserveN(now, 1)
+	if d := res.DelayFrom(now); d > 0 {
+		res.CancelAt(now)
+		return d
+	}
+	return 0
+}
+
+/* Drops buckets not drawn from since before, which have long since refilled */
+func (bs *bucketSet) Prune(before time.Time) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+
+	for key, b := range bs.buckets {
+		if b.seen.Before(before) {
+			delete(bs.buckets, key)
+		}
+	}
+}
+
+/* Address of the calling client. Calls proxied by the gateway arrive from */
+/* loopback, so the address the gateway saw is taken from x-forwarded-for instead */
+func clientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+			/* The gateway appends the address it saw; earlier entries are client-supplied */
+			hops := strings.Split(fwd[len(fwd)-1], ",")
+			if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+				return last
+			}
+		}
+	}
+	return host
+}
+
+/* Username a request acts for, if it names one */
+func requestUser(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetUsername() string }:
+		return r.GetUsername()
+	case interface{ GetHost() string }:
+		return r.GetHost()
+	}
+	return ""
+}
+
+/* Health checks, reflection and the token-guarded AdminService are never limited */
+func rateLimitExempt(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.") ||
+		strings.HasPrefix(method, "/grpc.reflection.") ||
+		strings.HasPrefix(method, "/"+hangmanv1.AdminService_ServiceDesc.ServiceName+"/")
+}
+
+/* Draws from the client's bucket, then from the bucket of any user the request names */
+func checkRate(ctx context.Context, method string, req interface{}) error {
+	if rateLimitExempt(method) {
+		return nil
+	}
+
+	l := currentRateLimits()
+	now := time.Now()
+
+	addr := clientAddr(ctx)
+	if wait := clientBuckets.Take(addr, l.ClientRate, l.ClientBurst, now); wait > 0 {
+		rateLimited.WithLabelValues("client").Inc()
+		return status.Errorf(codes.ResourceExhausted, "Too many requests from %s, retry in %v", addr, wait.Round(time.Millisecond))
+	}
+
+	if username := requestUser(req); username != "" {
+		if wait := userBuckets.Take(username, l.UserRate, l.UserBurst, now); wait > 0 {
+			rateLimited.WithLabelValues("user").Inc()
+			return status.Errorf(codes.ResourceExhausted, "Too many requests for user %s, retry in %v", username, wait.Round(time.Millisecond))
+		}
+	}
+	return nil
+}
+
+/* Rejects unary calls over the client or user rate limit */
+func rateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkRate(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+/* Rejects streams opened over the client rate limit */
+func rateLimitStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := checkRate(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+/* Serialises game creation so the active game caps cannot be raced past */
+var ownedMux sync.Mutex
+
+/* Creates a game owned by owner with create, unless the owner, the client */
+/* address calling or the server already has as many active games as allowed. */
+/* Usernames are whatever the client says, so the address caps one client */
+/* handing out a fresh username for every game */
+func createOwned(owner, addr string, create func() int) (int, error) {
+	ownedMux.Lock()
+	defer ownedMux.Unlock()
+
+	l := currentRateLimits()
+
+	if l.MaxGames > 0 || l.MaxUserGames > 0 {
+		active, owned, fromAddr := 0, 0, 0
+		for _, pGame := range sortedGames() {
+			pGame.mux.Lock()
+			if pGame.gameState {
+				active++
+				if pGame.owner == owner {
+					owned++
+				}
+				if pGame.ownerAddr == addr {
+					fromAddr++
+				}
+			}
+			pGame.mux.Unlock()
+		}
+
+		switch {
+		case l.MaxGames > 0 && active >= l.MaxGames:
+			rateLimited.WithLabelValues("games").Inc()
+			return 0, status.Errorf(codes.ResourceExhausted, "Server has %d active games, the maximum; try again once some finish", active)
+		case l.MaxUserGames > 0 && owned >= l.MaxUserGames:
+			rateLimited.WithLabelValues("user_games").Inc()
+			return 0, status.Errorf(codes.ResourceExhausted, "%s already has %d active games, the maximum; finish one first", owner, owned)
+		case l.MaxUserGames > 0 && fromAddr >= l.MaxUserGames:
+			rateLimited.WithLabelValues("user_games").Inc()
+			return 0, status.Errorf(codes.ResourceExhausted, "Client %s already has %d active games, the maximum; finish one first", addr, fromAddr)
+		}
+	}
+
+	gameNo := create()
+	if pGame, ok := findGame(gameNo); ok {
+		pGame.mux.Lock()
+		pGame.owner = owner
+		pGame.ownerAddr = addr
+		pGame.mux.Unlock()
+	}
+	return gameNo, nil
+}
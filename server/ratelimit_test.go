@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+/* Context of a call arriving from addr */
+func fromAddr(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 4000}})
+}
+
+func TestBucketAllowsBurstThenRefills(t *testing.T) {
+	bs := &bucketSet{buckets: make(map[string]*bucket)}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if wait := bs.Take("alice", 1, 2, now); wait != 0 {
+			t.Fatalf("Call %d waited %v, want it within the burst", i+1, wait)
+		}
+	}
+	if wait := bs.Take("alice", 1, 2, now); wait <= 0 || wait > time.Second {
+		t.Fatalf("Call past the burst waited %v, want up to 1s", wait)
+	}
+
+	/* Each key has its own bucket */
+	if wait := bs.Take("bob", 1, 2, now); wait != 0 {
+		t.Errorf("Bob waited %v behind alice's bucket", wait)
+	}
+
+	/* A second later one more token has been added */
+	later := now.Add(time.Second)
+	if wait := bs.Take("alice", 1, 2, later); wait != 0 {
+		t.Errorf("Call after refilling waited %v", wait)
+	}
+	if wait := bs.Take("alice", 1, 2, later); wait == 0 {
+		t.Errorf("Second call after one token refilled was allowed")
+	}
+
+	/* A zero rate disables the limit */
+	for i := 0; i < 10; i++ {
+		if wait := bs.Take("carol", 0, 0, now); wait != 0 {
+			t.Fatalf("Unlimited call waited %v", wait)
+		}
+	}
+}
+
+func TestRateLimitRejectsClientOverBurst(t *testing.T) {
+	resetServer(t)
+	clientBuckets.Prune(time.Now().Add(time.Hour))
+	t.Cleanup(func() { clientBuckets.Prune(time.Now().Add(time.Hour)) })
+
+	setRateLimits(rateLimits{ClientRate: 0.1, ClientBurst: 2})
+
+	_, cc := startServer(t)
+	sc := hangmanv1.NewHangmanServiceClient(cc)
+
+	for i := 0; i < 2; i++ {
+		if _, err := sc.List(context.Background(), &hangmanv1.ListRequest{}); err != nil {
+			t.Fatalf("List %d: %v", i+1, err)
+		}
+	}
+	if _, err := sc.List(context.Background(), &hangmanv1.ListRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("List past the burst returned %v, want ResourceExhausted", err)
+	}
+}
+
+func TestActiveGameCapsPerUserAndAddress(t *testing.T) {
+	resetServer(t)
+	setRateLimits(rateLimits{MaxUserGames: 2})
+	srv := &server{}
+
+	create := func(addr, username string) (int32, error) {
+		res, err := srv.NewGame(fromAddr(addr), &hangmanv1.NewGameRequest{Username: username})
+		if err != nil {
+			return 0, err
+		}
+		return res.GameId, nil
+	}
+
+	first, err := create("203.0.113.1", "alice")
+	if err != nil {
+		t.Fatalf("NewGame: %v", err)
+	}
+	if _, err := create("203.0.113.2", "alice"); err != nil {
+		t.Fatalf("NewGame: %v", err)
+	}
+
+	/* alice has two active games, wherever she calls from */
+	if _, err := create("203.0.113.3", "alice"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("alice's third game returned %v, want ResourceExhausted", err)
+	}
+
+	/* A client cannot dodge the cap by naming a new user for each game */
+	if _, err := create("203.0.113.1", "bob"); err != nil {
+		t.Fatalf("NewGame: %v", err)
+	}
+	if _, err := create("203.0.113.1", "carol"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Third game from one address returned %v, want ResourceExhausted", err)
+	}
+
+	/* Finishing a game frees its place */
+	pGame, _ := findGame(int(first))
+	pGame.mux.Lock()
+	pGame.gameState = false
+	pGame.mux.Unlock()
+
+	if _, err := create("203.0.113.1", "carol"); err != nil {
+		t.Errorf("NewGame after one finished: %v", err)
+	}
+}
+
+func TestActiveGameCapServerWide(t *testing.T) {
+	resetServer(t)
+	setRateLimits(rateLimits{MaxGames: 2})
+	srv := &server{}
+
+	for i, addr := range []string{"203.0.113.1", "203.0.113.2"} {
+		if _, err := srv.NewGame(fromAddr(addr), &hangmanv1.NewGameRequest{}); err != nil {
+			t.Fatalf("NewGame %d: %v", i+1, err)
+		}
+	}
+	if _, err := srv.NewGame(fromAddr("203.0.113.3"), &hangmanv1.NewGameRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Game past the server cap returned %v, want ResourceExhausted", err)
+	}
+}
+
+func TestWebSocketCallsCarryClientAddress(t *testing.T) {
+	r := httptest.NewRequest("GET", "/ws", nil)
+	r.RemoteAddr = "203.0.113.9:4000"
+	r.Header.Set("X-Forwarded-For", "198.51.100.7")
+
+	/* What the gRPC server sees of a call made on the WebSocket's behalf */
+	md, _ := metadata.FromOutgoingContext(forwardedFor(context.Background(), r))
+	ctx := metadata.NewIncomingContext(fromAddr("127.0.0.1"), md)
+
+	if got := clientAddr(ctx); got != "203.0.113.9" {
+		t.Errorf("Client address = %q, want the WebSocket peer 203.0.113.9", got)
+	}
+}
+
+/* Connects a WebSocket client subscribed to gameID on an in-process server */
+func dialGame(t *testing.T, url string, gameID int) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(url, "http")+"?game="+strconv.Itoa(gameID), nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	var res wsResponse
+	if err := conn.ReadJSON(&res); err != nil || res.Type != "game" {
+		t.Fatalf("First message %+v, %v, want the game", res, err)
+	}
+	return conn
+}
+
+/* Sends a guess over the connection, skipping pushed updates to return its reply */
+func wsGuess(t *testing.T, conn *websocket.Conn, letter string) wsResponse {
+	t.Helper()
+
+	if err := conn.WriteJSON(wsRequest{Type: "guess", Letter: letter}); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	for {
+		var res wsResponse
+		if err := conn.ReadJSON(&res); err != nil {
+			t.Fatalf("ReadJSON: %v", err)
+		}
+		if res.Type != "game" {
+			return res
+		}
+	}
+}
+
+func TestWebSocketPushesAndGuestsDoNotShareLimits(t *testing.T) {
+	resetServer(t)
+	for _, bs := range []*bucketSet{clientBuckets, userBuckets} {
+		bs.Prune(time.Now().Add(time.Hour))
+		t.Cleanup(func() { bs.Prune(time.Now().Add(time.Hour)) })
+	}
+	setRateLimits(rateLimits{ClientRate: 0.01, ClientBurst: 2, UserRate: 0.01, UserBurst: 1})
+
+	_, cc := startServer(t)
+	ts := httptest.NewServer(&wsHandler{client: hangmanv1.NewHangmanServiceClient(cc)})
+	defer ts.Close()
+
+	gameNo := newGame("hello")
+	first := dialGame(t, ts.URL, gameNo)
+	second := dialGame(t, ts.URL, gameNo)
+
+	/* Board updates for other players' guesses are pushed without using the */
+	/* subscribers' buckets */
+	srv := &server{}
+	for _, letter := range []string{"q", "w", "e"} {
+		if _, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: letter, Username: "carol"}); err != nil {
+			t.Fatalf("Guess: %v", err)
+		}
+	}
+
+	/* Each anonymous player has a user bucket of their own */
+	for i, tc := range []struct {
+		conn   *websocket.Conn
+		letter string
+	}{{first, "h"}, {second, "l"}} {
+		if res := wsGuess(t, tc.conn, tc.letter); res.Type != "guess" {
+			t.Errorf("Anonymous player %d's guess got %q (%s), want it played", i+1, res.Type, res.Message)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"math/big"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	roomCodeLen = 6
+	/* Join code alphabet, leaving out letters and digits easily mistaken for each other */
+	roomCodeChars = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+/* Settings of a game hosted as a room, which players join with its code. */
+/* Settings are fixed when the room is created */
+type room struct {
+	Code         string `json:"code"`
+	Host         string `json:"host"`
+	PasswordHash []byte `json:"password_hash,omitempty"`
+	MaxPlayers   int    `json:"max_players,omitempty"`
+	Private      bool   `json:"private,omitempty"`
+}
+
+/* Generates a random join code */
+func newRoomCode() (string, error) {
+	code := make([]byte, roomCodeLen)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(roomCodeChars))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = roomCodeChars[n.Int64()]
+	}
+	return string(code), nil
+}
+
+/* Hashes a room password, salted with the room's code */
+func roomPasswordHash(code, password string) []byte {
+	sum := sha256.Sum256([]byte(code + ":" + password))
+	return sum[:]
+}
+
+/* Reports whether the password opens the room, which any password does when none is set */
+func (r *room) CheckPassword(password string) bool {
+	if r.PasswordHash == nil {
+		return true
+	}
+	return subtle.ConstantTimeCompare(r.PasswordHash, roomPasswordHash(r.Code, password)) == 1
+}
+
+/* Reports whether the game is hidden from the public list */
+func (pGame *gameStore) IsPrivate() bool {
+	return (*pGame).room != nil && (*pGame).room.Private
+}
+
+/* Reports whether a user may see the game, which a private room only shows to its players */
+func (pGame *gameStore) MayView(name string) bool {
+	return !pGame.IsPrivate() || pGame.MayGuess(name)
+}
+
+/* Checks the game exists and, for a private room, that the user has joined it */
+func mayView(gameID int, username string) error {
+	pGame, ok := findGame(gameID)
+	if !ok {
+		return status.Errorf(codes.NotFound, "Game %d does not exist", gameID)
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	if !pGame.MayView(username) {
+		return status.Errorf(codes.PermissionDenied, "User %s has not joined Game %d", username, gameID)
+	}
+	return nil
+}
+
+/* Finds the game hosted under a join code */
+func findRoom(code string) (*gameStore, bool) {
+	for _, pGame := range sortedGames() {
+		if pGame.room != nil && pGame.room.Code == code {
+			return pGame, true
+		}
+	}
+	return nil, false
+}
+
+/* Adds a player to a room, enforcing its player limit */
+func (pGame *gameStore) Join(name string) error {
+	if pGame.MayGuess(name) {
+		return nil
+	}
+	if max := (*pGame).room.MaxPlayers; max > 0 && len((*pGame).entrants) >= max {
+		return status.Errorf(codes.ResourceExhausted, "Room is full, %d players maximum", max)
+	}
+	(*pGame).entrants = append((*pGame).entrants, name)
+	return nil
+}
+
+func (*server) CreateRoom(ctx context.Context, req *hangmanv1.CreateRoomRequest) (*hangmanv1.CreateRoomResponse, error) {
+	loggerFrom(ctx).Debug("CreateRoom function was invoked", "host", req.GetHost(), "private", req.GetPrivate())
+
+	host := req.GetHost()
+	switch {
+	case host == "":
+		return nil, status.Error(codes.InvalidArgument, "Host username is required")
+	case isBot(host):
+		return nil, errBotName
+	case isBanned(host):
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", host)
+	case req.GetMaxPlayers() < 0:
+		return nil, status.Error(codes.InvalidArgument, "Max players cannot be negative")
+	}
+
+	/* Regenerate the rare code already in use */
+	code, err := newRoomCode()
+	for err == nil {
+		if _, taken := findRoom(code); !taken {
+			break
+		}
+		code, err = newRoomCode()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to generate join code: %v", err)
+	}
+
+	r := &room{Code: code, Host: host, MaxPlayers: int(req.GetMaxPlayers()), Private: req.GetPrivate()}
+	if req.GetPassword() != "" {
+		r.PasswordHash = roomPasswordHash(code, req.GetPassword())
+	}
+
+	word, err := randomWord()
+	if req.Seed != nil {
+		word, err = seededWord(req.GetSeed())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gameNo, err := createOwned(host, clientAddr(ctx), func() int { return newRoom(word, r) })
+	if err != nil {
+		return nil, err
+	}
+
+	loggerFrom(ctx).Info("Room created", "game_id", gameNo, "host", host, "private", r.Private, "max_players", r.MaxPlayers)
+	if !r.Private {
+		gameEvents.Publish(gameEvent{GameID: gameNo})
+	}
+
+	return &hangmanv1.CreateRoomResponse{GameId: int32(gameNo), Code: code}, nil
+}
+
+func (*server) JoinRoom(ctx context.Context, req *hangmanv1.JoinRoomRequest) (*hangmanv1.JoinRoomResponse, error) {
+	loggerFrom(ctx).Debug("JoinRoom function was invoked", "code", req.GetCode(), "username", req.GetUsername())
+
+	username := req.GetUsername()
+	switch {
+	case username == "":
+		return nil, status.Error(codes.InvalidArgument, "Username is required")
+	case isBot(username):
+		return nil, errBotName
+	case isBanned(username):
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	}
+
+	pGame, ok := findRoom(req.GetCode())
+	if !ok || !pGame.room.CheckPassword(req.GetPassword()) {
+		/* The same error either way, so codes cannot be probed without the password */
+		return nil, status.Error(codes.PermissionDenied, "Unknown join code or wrong password")
+	}
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	if pGame.kicked[username] {
+		return nil, status.Errorf(codes.PermissionDenied, "User %s was kicked from Game %d", username, pGame.gameID)
+	}
+
+	if err := pGame.Join(username); err != nil {
+		return nil, err
+	}
+
+	loggerFrom(ctx).Info("Room joined", "game_id", pGame.gameID, "username", username)
+
+	return &hangmanv1.JoinRoomResponse{Game: playerGame(pGame)}, nil
+}
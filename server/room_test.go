@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Creates a private room hosted by alice, returning its game ID */
+func privateRoom(t *testing.T) int32 {
+	t.Helper()
+
+	res, err := (&server{}).CreateRoom(context.Background(), &hangmanv1.CreateRoomRequest{Host: "alice", Private: true})
+	if err != nil {
+		t.Fatalf("CreateRoom: %v", err)
+	}
+	return res.GameId
+}
+
+func TestGetGameHidesPrivateRoomFromOutsiders(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	gameID := privateRoom(t)
+
+	if _, err := srv.GetGame(context.Background(), &hangmanv1.GetGameRequest{GameId: gameID, Username: "mallory"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetGame by an outsider returned %v, want PermissionDenied", err)
+	}
+	if _, err := srv.GetGame(context.Background(), &hangmanv1.GetGameRequest{GameId: gameID, Username: "alice"}); err != nil {
+		t.Errorf("GetGame by the host: %v", err)
+	}
+}
+
+func TestEventsHidePrivateRoomFromOutsiders(t *testing.T) {
+	resetServer(t)
+	gameID := privateRoom(t)
+
+	r := httptest.NewRequest("GET", "/events?game="+strconv.Itoa(int(gameID))+"&username=mallory", nil)
+	w := httptest.NewRecorder()
+	serveEvents(w, r)
+
+	if w.Code != http.StatusForbidden || strings.Contains(w.Body.String(), "event: game") {
+		t.Errorf("Outsider's event stream got %d %q, want 403 and no game", w.Code, w.Body.String())
+	}
+}
+
+func TestWebSocketRefusesPrivateRoomSubscription(t *testing.T) {
+	resetServer(t)
+	_, cc := startServer(t)
+	gameID := privateRoom(t)
+
+	ts := httptest.NewServer(&wsHandler{client: hangmanv1.NewHangmanServiceClient(cc)})
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer conn.Close()
+
+	for _, tc := range []struct {
+		username string
+		want     string
+	}{
+		{"mallory", "error"},
+		{"alice", "game"},
+	} {
+		if err := conn.WriteJSON(wsRequest{Type: "subscribe", GameID: int(gameID), Username: tc.username}); err != nil {
+			t.Fatalf("WriteJSON: %v", err)
+		}
+
+		var res wsResponse
+		if err := conn.ReadJSON(&res); err != nil {
+			t.Fatalf("ReadJSON: %v", err)
+		}
+		if res.Type != tc.want {
+			t.Errorf("Subscribe as %s got %q (%s), want %q", tc.username, res.Type, res.Message, tc.want)
+		}
+	}
+}
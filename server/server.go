@@ -3,64 +3,268 @@
 // Author: hill399
 
 // Usage: Launches rpc server which the client-side application can interact with.
+// "hangman.v1.HangmanService" serves all game RPCs:
 // "NewGame" Generates new game and stores active game data.
 // "List" Generates list of all currently running games.
+// "CreateRoom"/"JoinRoom" Host games joined by code, with optional password, player limit and privacy.
+// "GetGame" Retrieves the full state of a single game.
 // "Guess" Accepts and evaluates user guesses.
+// "GetDaily"/"GuessDaily" Play the daily puzzle, one attempt per player at the same word.
+// "SuggestLetter" Ranks the best next letter for a game, optionally at a cost in turns.
+// "AddBot" Adds a server-side bot player which takes a turn after each human guess.
+// "GameChat" Bidirectional stream carrying a game's chat and reactions.
+// The same RPCs are exposed as REST/JSON over HTTP by a gateway.
+// "Ping" Reports server version, alongside grpc.health.v1 and server reflection.
+// The pre-v1 GuessService, NewGameService and ListService remain as compatibility shims.
+// "TournamentService" Round-robin and knockout tournaments played out over ordinary games.
+// "AdminService" Token-authenticated endpoints for inspecting and controlling games.
+// Calls are rate limited per client and per user, and active games capped per user and server-wide.
+// Idle games are forfeited and finished games archived by a background janitor.
+// Settings come from a YAML config file, HANGMAN_* environment variables and flags; SIGHUP reloads them where safe.
+// On SIGINT/SIGTERM in-flight calls are drained and game state flushed to disk.
 
 
 package main
 
 import (
+	"crypto/tls"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"math/rand"
 	"context"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/hill399/HangmanGo/hangmanpb"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
-type server struct{}
-
-/* Array to store created games */
-var openGames []gameStore
+/* Implements hangman.v1 services; the legacy single-RPC services are served by legacyServer */
+type server struct {
+	hangmanv1.UnimplementedHangmanServiceServer
+	hangmanv1.UnimplementedAdminServiceServer
+	hangmanv1.UnimplementedTournamentServiceServer
+}
 
 func main() {
-	fmt.Println("---------------------------")
-	fmt.Println("Hangman CLI Server Side App")
-	fmt.Println("---------------------------")
-	fmt.Println("Listening at localhost:50051")
+	cfg, err := loadConfig(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+		os.Exit(2)
+	}
 
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	logger, err := newLogger(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid logging options: %v\n", err)
+		os.Exit(2)
+	}
+	slog.SetDefault(logger)
+
+	lis, err := net.Listen("tcp", cfg.Listen)
 
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		slog.Error("Failed to listen", "error", err)
+		os.Exit(1)
 	}
 
-	s := grpc.NewServer()
-	hangmanpb.RegisterGuessServiceServer(s, &server{})
-	hangmanpb.RegisterNewGameServiceServer(s, &server{})
-	hangmanpb.RegisterListServiceServer(s, &server{})
+	/* Refuse to start without words rather than fail every new game */
+	if cfg.Game.Words != "" {
+		fw, err := newFileWordSource(cfg.Game.Words)
+		if err != nil {
+			slog.Error("Failed to load word list", "path", cfg.Game.Words, "error", err)
+			os.Exit(1)
+		}
+		words = fw
+	} else {
+		bs, err := newBabbleSource()
+		if err != nil {
+			slog.Error("Failed to load the system dictionary, install one or pass -words", "error", err)
+			os.Exit(1)
+		}
+		words = bs
+	}
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve %v", err)
+	dailySeed = cfg.Game.DailySeed
+	hintCost = int32(cfg.Game.HintCost)
+	defaultTurns = int32(cfg.Game.Turns)
+	setRateLimits(cfg.RateLimits)
+	if cfg.Game.Seed != 0 {
+		seedWords(rand.NewSource(cfg.Game.Seed))
 	}
-}
 
-func (*server) Guess(ctx context.Context, req *hangmanpb.GuessRequest) (*hangmanpb.GuessResponse, error) {
+	store := newStorage(cfg.Storage)
+
+	/* Restore games flushed by the previous run */
+	state, err := store.LoadState()
+	if err != nil {
+		slog.Error("Failed to load game state", "path", cfg.Storage.State, "error", err)
+		os.Exit(1)
+	}
+	restoreState(state)
+	if len(state.Games) > 0 || len(state.Banned) > 0 {
+		slog.Info("Game state restored", "games", len(state.Games), "banned", len(state.Banned), "path", cfg.Storage.State)
+	}
 
-	fmt.Printf("Guess function was invoked with %v", req)
+	/* Without a seed the daily word would follow from the word list alone, so */
+	/* keep the seed generated by the previous run, or generate one */
+	if dailySeed == "" {
+		dailySeed = state.DailySeed
+	}
+	if dailySeed == "" {
+		dailySeed = newDailySeed()
+		slog.Warn("No daily seed configured, generated one; set -daily-seed to keep the daily word if the state file is lost")
+	}
 
-	gameNo := req.GetGuess().GetGameNumber()
-	guess := req.GetGuess().GetGuessLetter()
-	username := req.GetGuess().GetUsername()
+	/* Cancelled on SIGINT/SIGTERM to begin graceful shutdown */
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	det := []string{}
+	/* Start janitor to expire idle games and archive finished ones */
+	j := &janitor{interval: cfg.Retention.SweepInterval, idleTimeout: cfg.Retention.IdleTimeout, retention: cfg.Retention.Finished, store: store}
+	go j.Run(ctx)
 
-	/* mutex lock game to alter for concurrency purposes */
-	openGames[gameNo].mux.Lock()
+	/* Apply the settings which can change safely on SIGHUP */
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func(running *config) {
+		for range hup {
+			running = reloadConfig(running, os.Args[1:], j)
+		}
+	}(cfg)
+
+	/* Credentials the gateway dials the gRPC server with */
+	dialCreds := insecure.NewCredentials()
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(metricsInterceptor, requestLogInterceptor, rateLimitInterceptor, adminAuthInterceptor(cfg.AdminToken)),
+		grpc.ChainStreamInterceptor(metricsStreamInterceptor, requestLogStreamInterceptor, rateLimitStreamInterceptor),
+	}
+	if cfg.TLS.Enabled() {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLS.Cert, cfg.TLS.Key)
+		if err != nil {
+			slog.Error("Failed to load TLS certificate", "error", err)
+			os.Exit(1)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+		/* The gateway only dials this process, whose certificate names its public host */
+		/* rather than the local address, so the certificate is not verified on that hop */
+		dialCreds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+	}
 
-	pGame := &openGames[gameNo]
+	var httpSrvs []*http.Server
+
+	/* Serve Prometheus metrics alongside the gRPC server */
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		httpSrvs = append(httpSrvs, serveHTTP("Metrics", cfg.MetricsAddr, mux, tlsFiles{}))
+	}
+
+	/* Serve REST/JSON gateway proxying onto the gRPC server */
+	if cfg.HTTPAddr != "" {
+		gw, err := newGateway(ctx, lis.Addr().String(), dialCreds)
+		if err != nil {
+			slog.Error("Failed to start gateway", "error", err)
+			os.Exit(1)
+		}
+		httpSrvs = append(httpSrvs, serveHTTP("Gateway", cfg.HTTPAddr, gw, cfg.TLS))
+	}
+
+	s := grpc.NewServer(serverOpts...)
+	srv := &server{}
+	hangmanv1.RegisterHangmanServiceServer(s, srv)
+	hangmanv1.RegisterAdminServiceServer(s, srv)
+	hangmanv1.RegisterTournamentServiceServer(s, srv)
+
+	/* Compatibility shims for clients still using the pre-v1 services */
+	legacy := &legacyServer{v1: srv}
+	hangmanpb.RegisterGuessServiceServer(s, legacy)
+	hangmanpb.RegisterNewGameServiceServer(s, legacy)
+	hangmanpb.RegisterListServiceServer(s, legacy)
+	hs := registerHealth(s)
+
+	slog.Info("Hangman server listening", "addr", lis.Addr().String(), "tls", cfg.TLS.Enabled(), "version", version)
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			slog.Error("Failed to serve", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+
+	shutdown(s, hs, httpSrvs, store, cfg.ShutdownTimeout)
+}
+
+/* Starts an HTTP server in the background, over TLS when given a certificate, */
+/* returning it for shutdown */
+func serveHTTP(name, addr string, h http.Handler, tf tlsFiles) *http.Server {
+	srv := &http.Server{Addr: addr, Handler: h}
+	go func() {
+		slog.Info(name+" listening", "addr", addr, "tls", tf.Enabled())
+		var err error
+		if tf.Enabled() {
+			err = srv.ListenAndServeTLS(tf.Cert, tf.Key)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			slog.Error("Failed to serve "+strings.ToLower(name), "error", err)
+		}
+	}()
+	return srv
+}
+
+/* Converts game into its player-facing view, hiding the secret word */
+func playerGame(pGame *gameStore) *hangmanv1.Game {
+	return &hangmanv1.Game{
+		GameId:    int32(pGame.gameID),
+		WordState: strings.Join(pGame.completeWord, ""),
+		Turns:     int32(pGame.turns),
+		Active:    pGame.gameState,
+		Winner:    pGame.winner,
+		MaxTurns:  int32(pGame.maxTurns),
+		Room:      pGame.room != nil,
+		Private:   pGame.IsPrivate(),
+		Evil:      pGame.candidates != nil,
+		Hits:      append([]string(nil), pGame.hits...),
+		Misses:    append([]string(nil), pGame.misses...),
+	}
+}
+
+/* Lowercases a guess, rejecting anything but a single letter a-z */
+func guessLetter(guess string) (string, error) {
+	if len(guess) != 1 || !('a' <= guess[0] && guess[0] <= 'z' || 'A' <= guess[0] && guess[0] <= 'Z') {
+		return "", status.Errorf(codes.InvalidArgument, "Guess %q is not a single letter a-z", guess)
+	}
+	return strings.ToLower(guess), nil
+}
+
+/* Validates and applies a guess to a locked game, returning detail lines for the player */
+func playGuess(ctx context.Context, pGame *gameStore, username, guess string) ([]string, error) {
+	guess, err := guessLetter(guess)
+	if err != nil {
+		guessesTotal.WithLabelValues("invalid").Inc()
+		return nil, err
+	}
+
+	det := []string{}
 
 	/* Check if game is active */
 	pGame.IsGameActive(&det)
@@ -70,12 +274,27 @@ func (*server) Guess(ctx context.Context, req *hangmanpb.GuessRequest) (*hangman
 	/* If game active, validate letter */
 	if pGame.gameState == true {
 		validLetter = pGame.IsLetterValid(guess, &det)
+		if validLetter == false {
+			guessesTotal.WithLabelValues("repeat").Inc()
+		}
+	} else {
+		guessesTotal.WithLabelValues("inactive").Inc()
 	}
 
 	if pGame.gameState == true && validLetter == true {
+		pGame.lastActivity = time.Now()
+		pGame.AddPlayer(username)
+
 		/* Loop through win word and evaluate against char guess */
+		turnsBefore := pGame.turns
 		pGame.EvaluateGuess(guess, &det)
 
+		if pGame.turns < turnsBefore {
+			guessesTotal.WithLabelValues("miss").Inc()
+		} else {
+			guessesTotal.WithLabelValues("hit").Inc()
+		}
+
 		pGame.EvaluateWinState(username)
 
 		if pGame.turns == 0 {
@@ -83,59 +302,171 @@ func (*server) Guess(ctx context.Context, req *hangmanpb.GuessRequest) (*hangman
 		}
 
 		if pGame.gameState != true {
+			pGame.ended = pGame.lastActivity
+			tournamentGameOver(pGame)
+
 			if pGame.turns == 0 {
-				det = append(det, fmt.Sprintf("You lose, Game %d over!\n", gameNo))
+				det = append(det, fmt.Sprintf("You lose, %s over!\n", pGame.Name()))
+				gamesFinished.WithLabelValues("loss").Inc()
 			} else {
-				det = append(det, fmt.Sprintf("You are the winner of Game %d!\n", gameNo))
+				det = append(det, fmt.Sprintf("You are the winner of %s!\n", pGame.Name()))
+				gamesFinished.WithLabelValues("win").Inc()
 			}
 		}
 
-		/* Print to server console */
-		fmt.Printf("Guess made on game %d\n", gameNo)
-		fmt.Println(pGame)
+		loggerFrom(ctx).Info("Guess made", "username", username, "letter", guess, "game", pGame)
 	}
 
-	/* Unlock mutex to allow for next user to attempt */
-	openGames[gameNo].mux.Unlock()
+	return det, nil
+}
+
+func (srv *server) Guess(ctx context.Context, req *hangmanv1.GuessRequest) (*hangmanv1.GuessResponse, error) {
+
+	loggerFrom(ctx).Debug("Guess function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+	guess := req.GetLetter()
+	username := req.GetUsername()
+
+	if isBanned(username) {
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	}
+
+	if isBot(username) && !fromBot(ctx) {
+		return nil, errBotName
+	}
 
-	fmt.Println("Printing detail slice")
-	for _, line := range det {
-		fmt.Println(line)
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
 	}
 
-	res := &hangmanpb.GuessResponse{
-		Response: pGame.PrintGame(),
+	/* mutex lock game to alter for concurrency purposes */
+	pGame.mux.Lock()
+
+	if pGame.kicked[username] {
+		pGame.mux.Unlock()
+		return nil, status.Errorf(codes.PermissionDenied, "User %s was kicked from Game %d", username, gameNo)
+	}
+
+	if !pGame.MayGuess(username) {
+		pGame.mux.Unlock()
+		if pGame.room != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "User %s has not joined Game %d, join with its code first", username, gameNo)
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is not playing Game %d", username, gameNo)
+	}
+
+	played := len(pGame.hits) + len(pGame.misses)
+	det, err := playGuess(ctx, pGame, username, guess)
+	if err != nil {
+		pGame.mux.Unlock()
+		return nil, err
+	}
+
+	res := &hangmanv1.GuessResponse{
+		Game:   playerGame(pGame),
 		Detail: det,
 	}
 
+	/* Bots take their turns once a human has played a letter */
+	botsTurn := !fromBot(ctx) && len(pGame.bots) > 0 && pGame.gameState && len(pGame.hits)+len(pGame.misses) > played
+
+	/* Unlock mutex to allow for next user to attempt */
+	pGame.mux.Unlock()
+
+	gameEvents.Publish(gameEvent{GameID: int(gameNo)})
+
+	loggerFrom(ctx).Debug("Guess detail", "game_id", gameNo, "detail", det)
+
+	if botsTurn {
+		startBots(srv, int(gameNo))
+	}
+
 	return res, nil
 }
 
-func (*server) NewGame(ctx context.Context, req *hangmanpb.NewGameRequest) (*hangmanpb.NewGameResponse, error) {
-	fmt.Printf("NewGame function was invoked")
+func (*server) GetGame(ctx context.Context, req *hangmanv1.GetGameRequest) (*hangmanv1.GetGameResponse, error) {
+	loggerFrom(ctx).Debug("GetGame function was invoked", "req", req)
 
-	gameNo := newGame()
+	gameNo := req.GetGameId()
 
-	res := &hangmanpb.NewGameResponse{
-		GameNumber: int32(gameNo),
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
 	}
 
+	pGame.mux.Lock()
+	if !pGame.MayView(req.GetUsername()) {
+		pGame.mux.Unlock()
+		return nil, status.Errorf(codes.PermissionDenied, "User %s has not joined Game %d", req.GetUsername(), gameNo)
+	}
+	res := &hangmanv1.GetGameResponse{
+		Game:                 playerGame(pGame),
+		Players:              append([]string(nil), pGame.players...),
+		Bots:                 pGame.BotNames(),
+		CreatedUnixNano:      pGame.created.UnixNano(),
+		LastActivityUnixNano: pGame.lastActivity.UnixNano(),
+	}
+	if !pGame.ended.IsZero() {
+		res.EndedUnixNano = pGame.ended.UnixNano()
+	}
+	pGame.mux.Unlock()
+
 	return res, nil
 }
 
-func (*server) List(ctx context.Context, req *hangmanpb.ListRequest) (*hangmanpb.ListResponse, error) {
-	fmt.Printf("List function was invoked") 
+func (*server) NewGame(ctx context.Context, req *hangmanv1.NewGameRequest) (*hangmanv1.NewGameResponse, error) {
+	loggerFrom(ctx).Debug("NewGame function was invoked")
 
-	sa := make([]string, len(openGames))
-	
-	sa = append(sa, fmt.Sprintf("\nGAME ID| WINNER | PLAYABLE | TURNS | WORD STATE\n"))
+	word, err := randomWord()
+	if req.Seed != nil {
+		word, err = seededWord(req.GetSeed())
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	for _, game := range openGames {
-		sa = append(sa, game.PrintGame())
+	addr := clientAddr(ctx)
+	owner := req.GetUsername()
+	if owner == "" {
+		owner = addr
 	}
 
-	res := &hangmanpb.ListResponse{
-		GameDetails: sa,
+	gameNo, err := createOwned(owner, addr, func() int {
+		if req.GetEvil() {
+			return newEvilGame(word)
+		}
+		return newGame(word)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loggerFrom(ctx).Info("Game created", "game_id", gameNo, "owner", owner, "seeded", req.Seed != nil, "evil", req.GetEvil())
+	gameEvents.Publish(gameEvent{GameID: gameNo})
+
+	res := &hangmanv1.NewGameResponse{
+		GameId: int32(gameNo),
+	}
+
+	return res, nil
+}
+
+func (*server) List(ctx context.Context, req *hangmanv1.ListRequest) (*hangmanv1.ListResponse, error) {
+	loggerFrom(ctx).Debug("List function was invoked")
+
+	res := &hangmanv1.ListResponse{}
+
+	for _, pGame := range sortedGames() {
+		/* Private rooms are only reachable with their join code */
+		if pGame.IsPrivate() {
+			continue
+		}
+
+		pGame.mux.Lock()
+		res.Games = append(res.Games, playerGame(pGame))
+		pGame.mux.Unlock()
 	}
 
 	return res, nil
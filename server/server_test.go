@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+/* Word list test games draw from, so tests never depend on the system dictionary */
+var testWords = sliceWords{"cat", "dog", "mast", "mist", "must", "hello", "zebra", "apple"}
+
+/* Fixed word source for tests */
+type sliceWords []string
+
+func (w sliceWords) Words() []string      { return append([]string(nil), w...) }
+func (w sliceWords) Reload() (int, error) { return len(w), nil }
+
+func (w sliceWords) Nth(n uint64) (string, error) {
+	if len(w) == 0 {
+		return "", errNoWords
+	}
+	return w[n%uint64(len(w))], nil
+}
+
+/* Clears every game, tournament and limit so each test starts from an empty server */
+func resetServer(t *testing.T) {
+	t.Helper()
+
+	restoreState(serverState{})
+	words = testWords
+	setRateLimits(rateLimits{})
+	hintCost = 0
+	defaultTurns = 8
+}
+
+/* Serves the hangman services in-process, returning a client connection to them */
+func startServer(t *testing.T) (*grpc.Server, *grpc.ClientConn) {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(rateLimitInterceptor),
+		grpc.ChainStreamInterceptor(rateLimitStreamInterceptor),
+	)
+	srv := &server{}
+	hangmanv1.RegisterHangmanServiceServer(s, srv)
+	hangmanv1.RegisterTournamentServiceServer(s, srv)
+	go s.Serve(lis)
+
+	cc, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial test server: %v", err)
+	}
+
+	t.Cleanup(func() {
+		cc.Close()
+		s.Stop()
+	})
+	return s, cc
+}
+
+func TestGuessRejectsInvalidLetters(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	ctx := context.Background()
+
+	gameNo := newGame("cat")
+
+	for _, letter := range []string{"", "ZZ", "ab", "é", "1", " ", "-"} {
+		_, err := srv.Guess(ctx, &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: letter, Username: "alice"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Guess(%q) = %v, want InvalidArgument", letter, err)
+		}
+	}
+
+	pGame, _ := findGame(gameNo)
+	if pGame.turns != pGame.maxTurns || len(pGame.misses) != 0 || len(pGame.hits) != 0 {
+		t.Fatalf("Invalid guesses were played: turns %d/%d, hits %q, misses %q", pGame.turns, pGame.maxTurns, pGame.hits, pGame.misses)
+	}
+}
+
+func TestGuessLowercasesLetter(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	gameNo := newGame("cat")
+
+	res, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: "C", Username: "alice"})
+	if err != nil {
+		t.Fatalf("Guess(C): %v", err)
+	}
+	if res.Game.WordState != "c__" || res.Game.Turns != res.Game.MaxTurns {
+		t.Errorf("Guess(C) left %q with %d/%d turns, want a hit on c", res.Game.WordState, res.Game.Turns, res.Game.MaxTurns)
+	}
+	if want := []string{"c"}; len(res.Game.Hits) != 1 || res.Game.Hits[0] != want[0] {
+		t.Errorf("Hits = %q, want %q", res.Game.Hits, want)
+	}
+}
+
+func TestGuessDailyRejectsInvalidLetters(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	ctx := context.Background()
+
+	for _, letter := range []string{"", "ZZ", "é"} {
+		_, err := srv.GuessDaily(ctx, &hangmanv1.GuessDailyRequest{Letter: letter, Username: "alice"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GuessDaily(%q) = %v, want InvalidArgument", letter, err)
+		}
+	}
+
+	res, err := srv.GetDaily(ctx, &hangmanv1.GetDailyRequest{Username: "alice"})
+	if err != nil {
+		t.Fatalf("GetDaily: %v", err)
+	}
+	if res.Game.Turns != res.Game.MaxTurns || len(res.Game.Misses) != 0 {
+		t.Errorf("Invalid daily guesses were played: turns %d/%d, misses %q", res.Game.Turns, res.Game.MaxTurns, res.Game.Misses)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+/* Cancelled as shutdown begins, so chat streams, server-sent events and WebSockets */
+/* return at once rather than holding the drain open until the deadline */
+var stopping, stopStreams = context.WithCancel(context.Background())
+
+/* Drains in-flight calls, then flushes game state so no game is lost mid-turn */
+func shutdown(s *grpc.Server, hs *health.Server, httpSrvs []*http.Server, store gameStorage, timeout time.Duration) {
+	start := time.Now()
+	slog.Info("Shutting down, draining in-flight calls", "timeout", timeout)
+
+	/* Report NOT_SERVING so health checks route traffic away while draining */
+	hs.Shutdown()
+
+	/* Long-lived streams never finish on their own, so end them before draining */
+	stopStreams()
+
+	/* Stop HTTP front ends first, as gateway requests are served through gRPC */
+	for _, srv := range httpSrvs {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		if err := srv.Shutdown(ctx); err != nil {
+			slog.Warn("Failed to stop HTTP server", "addr", srv.Addr, "error", err)
+		}
+		cancel()
+	}
+
+	/* Give in-flight calls until the deadline, then cut them off */
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	forced := false
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		slog.Warn("Shutdown deadline exceeded, forcing stop")
+		forced = true
+		s.Stop()
+		<-stopped
+	}
+
+	/* Bots guess without a call, so wait for any still taking a turn */
+	waitBots()
+
+	/* No calls or bot turns remain, so the snapshot cannot catch a game mid-guess */
+	state := snapshotState()
+
+	active := 0
+	for _, rec := range state.Games {
+		if rec.Active {
+			active++
+		}
+	}
+
+	flushed := len(state.Games)
+	if err := store.SaveState(state); err != nil {
+		slog.Error("Failed to flush game state", "error", err)
+		flushed = 0
+	}
+
+	slog.Info("Shutdown complete",
+		"games_flushed", flushed,
+		"active_games", active,
+		"forced", forced,
+		"duration", time.Since(start),
+	)
+}
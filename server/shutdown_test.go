@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+)
+
+func TestShutdownEndsLongLivedStreams(t *testing.T) {
+	resetServer(t)
+	t.Cleanup(func() { stopping, stopStreams = context.WithCancel(context.Background()) })
+
+	s, cc := startServer(t)
+	sc := hangmanv1.NewHangmanServiceClient(cc)
+
+	created, err := sc.NewGame(context.Background(), &hangmanv1.NewGameRequest{})
+	if err != nil {
+		t.Fatalf("NewGame: %v", err)
+	}
+
+	/* A chat stream which stays open until the server ends it */
+	chat, err := sc.GameChat(context.Background())
+	if err != nil {
+		t.Fatalf("GameChat: %v", err)
+	}
+	if err := chat.Send(&hangmanv1.GameChatRequest{GameId: created.GameId, Username: "alice", Text: "hi"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if _, err := chat.Recv(); err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+
+	/* A server-sent events stream held open by a browser */
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	httpSrv := &http.Server{Handler: http.HandlerFunc(serveEvents)}
+	go httpSrv.Serve(lis)
+
+	res, err := http.Get("http://" + lis.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("GET events: %v", err)
+	}
+	defer res.Body.Close()
+
+	sseDone := make(chan struct{})
+	go func() {
+		defer close(sseDone)
+		r := bufio.NewReader(res.Body)
+		for {
+			if _, err := r.ReadString('\n'); err != nil {
+				return
+			}
+		}
+	}()
+
+	const timeout = 5 * time.Second
+	start := time.Now()
+	shutdown(s, health.NewServer(), []*http.Server{httpSrv}, newFileStorage("", ""), timeout)
+
+	if elapsed := time.Since(start); elapsed >= timeout {
+		t.Fatalf("Shutdown took %v, waiting out the %v deadline", elapsed, timeout)
+	}
+
+	if _, err := chat.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("Chat stream ended with %v, want Unavailable", err)
+	}
+
+	select {
+	case <-sseDone:
+	case <-time.After(time.Second):
+		t.Errorf("Event stream still open after shutdown")
+	}
+}
+
+func TestShutdownStopsBotTurns(t *testing.T) {
+	resetServer(t)
+	t.Cleanup(func() { stopping, stopStreams = context.WithCancel(context.Background()) })
+
+	delay := botDelay
+	botDelay = time.Minute
+	t.Cleanup(func() { botDelay = delay })
+
+	s, _ := startServer(t)
+	srv := &server{}
+
+	gameNo := newGame("mast")
+	if _, err := srv.AddBot(context.Background(), &hangmanv1.AddBotRequest{GameId: int32(gameNo), Skill: hangmanv1.BotSkill_BOT_SKILL_FREQUENCY, Username: "alice"}); err != nil {
+		t.Fatalf("AddBot: %v", err)
+	}
+
+	/* The bot now waits out its delay before taking its turn */
+	if _, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: "a", Username: "alice"}); err != nil {
+		t.Fatalf("Guess: %v", err)
+	}
+
+	const timeout = 5 * time.Second
+	start := time.Now()
+	shutdown(s, health.NewServer(), nil, newFileStorage("", ""), timeout)
+
+	if elapsed := time.Since(start); elapsed >= timeout {
+		t.Fatalf("Shutdown took %v, waiting out the %v deadline", elapsed, timeout)
+	}
+
+	/* A guess after shutdown starts no bot turns */
+	if _, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameNo), Letter: "m", Username: "alice"}); err != nil {
+		t.Fatalf("Guess: %v", err)
+	}
+
+	waitBots()
+
+	pGame, _ := findGame(gameNo)
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	if played := len(pGame.hits) + len(pGame.misses); played != 2 || pGame.botsPlaying {
+		t.Errorf("Game played %d letters, bots playing %v; want only alice's 2 and no bot turn", played, pGame.botsPlaying)
+	}
+}
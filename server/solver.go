@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Letters by frequency in English text, ranking letters when the dictionary has no candidates left */
+const letterFrequency = "etaoinshrdlcumwfgypbvkjxqz"
+
+/* Turns each hint costs, set by -hint-cost */
+var hintCost int32
+
+/* A letter ranked by the solver */
+type letterScore struct {
+	letter string
+	/* Share of candidate words containing the letter */
+	probability float64
+	/* Bits of information expected from guessing it */
+	entropy float64
+}
+
+/* Filters the dictionary down to the words consistent with the revealed pattern */
+/* and the letters played: revealed letters appear nowhere else, misses nowhere */
+func solverCandidates(dict []string, pattern []string, hits, misses []string) []string {
+	played := make(map[rune]bool)
+	for _, l := range append(append([]string(nil), hits...), misses...) {
+		for _, r := range l {
+			played[r] = true
+		}
+	}
+
+	var candidates []string
+	for _, w := range dict {
+		runes := []rune(w)
+		if len(runes) != len(pattern) {
+			continue
+		}
+
+		ok := true
+		for i, r := range runes {
+			if pattern[i] == "_" {
+				ok = !played[r]
+			} else {
+				ok = string(r) == pattern[i]
+			}
+			if !ok {
+				break
+			}
+		}
+		if ok {
+			candidates = append(candidates, w)
+		}
+	}
+	return candidates
+}
+
+/* Ranks the letters not yet played, best first. Letters are scored by the entropy */
+/* of how they split the candidates, then by how many candidates contain them */
+func rankLetters(candidates []string, hits, misses []string) []letterScore {
+	played := make(map[string]bool)
+	for _, l := range append(append([]string(nil), hits...), misses...) {
+		played[l] = true
+	}
+
+	var scores []letterScore
+	for i, r := range letterFrequency {
+		letter := string(r)
+		if played[letter] {
+			continue
+		}
+
+		score := letterScore{letter: letter}
+
+		families := make(map[string]int)
+		containing := 0
+		for _, w := range candidates {
+			key := letterPattern([]rune(w), r)
+			families[key]++
+			if strings.ContainsRune(w, r) {
+				containing++
+			}
+		}
+
+		if len(candidates) > 0 {
+			score.probability = float64(containing) / float64(len(candidates))
+			for _, n := range families {
+				p := float64(n) / float64(len(candidates))
+				score.entropy -= p * math.Log2(p)
+			}
+		} else {
+			/* Nothing in the dictionary fits, so fall back on English letter frequency */
+			score.probability = 1 - float64(i)/float64(len(letterFrequency))
+		}
+
+		scores = append(scores, score)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].entropy != scores[j].entropy {
+			return scores[i].entropy > scores[j].entropy
+		}
+		return scores[i].probability > scores[j].probability
+	})
+	return scores
+}
+
+func (*server) SuggestLetter(ctx context.Context, req *hangmanv1.SuggestLetterRequest) (*hangmanv1.SuggestLetterResponse, error) {
+	loggerFrom(ctx).Debug("SuggestLetter function was invoked", "req", req)
+
+	gameNo := req.GetGameId()
+	username := req.GetUsername()
+
+	if isBanned(username) {
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", username)
+	}
+
+	pGame, ok := findGame(int(gameNo))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Game %d does not exist", gameNo)
+	}
+
+	/* Read the dictionary before taking the game lock, so a slow word source */
+	/* or a reload never holds up guesses in this game */
+	dict := words.Words()
+
+	pGame.mux.Lock()
+	defer pGame.mux.Unlock()
+
+	switch {
+	case pGame.kicked[username]:
+		return nil, status.Errorf(codes.PermissionDenied, "User %s was kicked from Game %d", username, gameNo)
+	case !pGame.MayGuess(username):
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is not playing Game %d", username, gameNo)
+	case !pGame.gameState:
+		return nil, status.Errorf(codes.FailedPrecondition, "Game %d is finished", gameNo)
+	}
+
+	cost := int(atomic.LoadInt32(&hintCost))
+	if cost > 0 && pGame.turns <= cost {
+		return nil, status.Errorf(codes.FailedPrecondition, "A hint costs %d turns, Game %d has %d left", cost, gameNo, pGame.turns)
+	}
+
+	/* Rank against the dictionary alone, as a player would, not the secret word */
+	candidates := solverCandidates(dict, pGame.completeWord, pGame.hits, pGame.misses)
+	scores := rankLetters(candidates, pGame.hits, pGame.misses)
+	if len(scores) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Every letter has been played in Game %d", gameNo)
+	}
+
+	pGame.turns -= cost
+	hintsTotal.Inc()
+
+	res := &hangmanv1.SuggestLetterResponse{
+		Letter:     scores[0].letter,
+		Candidates: int32(len(candidates)),
+		TurnsCost:  int32(cost),
+	}
+	for _, s := range scores {
+		res.Ranked = append(res.Ranked, &hangmanv1.LetterScore{Letter: s.letter, Probability: s.probability, Entropy: s.entropy})
+	}
+	res.Game = playerGame(pGame)
+
+	loggerFrom(ctx).Info("Hint given", "username", username, "letter", res.Letter, "candidates", len(candidates), "game", pGame)
+	if cost > 0 {
+		gameEvents.Publish(gameEvent{GameID: int(gameNo)})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+/* Serialisable form of a game, written out once it leaves memory */
+type gameRecord struct {
+	GameID       int       `json:"game_id"`
+	Active       bool      `json:"active"`
+	PlayWord     string    `json:"play_word"`
+	CompleteWord string    `json:"complete_word"`
+	Hits         []string  `json:"hits"`
+	Misses       []string  `json:"misses"`
+	Turns        int       `json:"turns"`
+	MaxTurns     int       `json:"max_turns"`
+	Winner       string    `json:"winner"`
+	Players      []string  `json:"players"`
+	Kicked       []string  `json:"kicked,omitempty"`
+	Created      time.Time `json:"created"`
+	LastActivity time.Time `json:"last_activity"`
+	Ended        time.Time `json:"ended"`
+	Daily        string    `json:"daily,omitempty"`
+	Entrants     []string  `json:"entrants,omitempty"`
+	Room         *room     `json:"room,omitempty"`
+	Candidates   []string  `json:"candidates,omitempty"`
+	Bots         []gameBot `json:"bots,omitempty"`
+	Owner        string    `json:"owner,omitempty"`
+	OwnerAddr    string    `json:"owner_addr,omitempty"`
+}
+
+/* Snapshot of in-memory games, flushed on shutdown and restored on start */
+type serverState struct {
+	NextGameID int          `json:"next_game_id"`
+	Games      []gameRecord `json:"games"`
+	Daily      []gameRecord `json:"daily,omitempty"`
+	DailySeed  string       `json:"daily_seed,omitempty"`
+
+	NextTournamentID int                `json:"next_tournament_id,omitempty"`
+	Tournaments      []tournamentRecord `json:"tournaments,omitempty"`
+
+	Banned []string `json:"banned,omitempty"`
+}
+
+/* Persistent storage for games that are no longer held in memory */
+type gameStorage interface {
+	/* Stores a finished game permanently */
+	Archive(rec gameRecord) error
+	/* Replaces the saved server state */
+	SaveState(state serverState) error
+	/* Returns the server state last saved, if any */
+	LoadState() (serverState, error)
+}
+
+/* Storage appending archived games to a JSON lines file, with state kept in a separate JSON file */
+/* Either path may be empty to disable that half */
+type fileStorage struct {
+	mux         sync.Mutex
+	archivePath string
+	statePath   string
+}
+
+/* Opens the configured storage backend */
+func newStorage(sc storageConfig) gameStorage {
+	if sc.Backend == "memory" {
+		/* Without paths, file storage keeps nothing once games leave memory */
+		return newFileStorage("", "")
+	}
+	return newFileStorage(sc.Archive, sc.State)
+}
+
+func newFileStorage(archivePath, statePath string) *fileStorage {
+	return &fileStorage{archivePath: archivePath, statePath: statePath}
+}
+
+func (fs *fileStorage) Archive(rec gameRecord) error {
+	if fs.archivePath == "" {
+		return nil
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+
+	f, err := os.OpenFile(fs.archivePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(f).Encode(rec); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+/* Writes state to a temporary file first so a crash never leaves it half written */
+func (fs *fileStorage) SaveState(state serverState) error {
+	if fs.statePath == "" {
+		return nil
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+
+	f, err := os.CreateTemp(filepath.Dir(fs.statePath), filepath.Base(fs.statePath)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(state); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), fs.statePath)
+}
+
+func (fs *fileStorage) LoadState() (serverState, error) {
+	var state serverState
+
+	if fs.statePath == "" {
+		return state, nil
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+
+	b, err := os.ReadFile(fs.statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal(b, &state)
+	return state, err
+}
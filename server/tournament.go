@@ -0,0 +1,633 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Formats a tournament can be played in */
+const (
+	roundRobin = "round_robin"
+	knockout   = "knockout"
+)
+
+/* Games a knockout match is played over before a draw goes to its higher seed */
+const maxMatchGames = 3
+
+/* A pairing within a round, decided by a game between its players. */
+/* A match with a single player is a bye */
+type match struct {
+	Round   int      `json:"round"`
+	Players []string `json:"players"`
+	GameID  int      `json:"game_id"`
+	Games   int      `json:"games"`
+	Winner  string   `json:"winner,omitempty"`
+	Done    bool     `json:"done"`
+}
+
+/* type struct to unique tournament data */
+type tournament struct {
+	mux        sync.Mutex
+	id         int
+	name       string
+	format     string
+	seed       *int64
+	players    []string
+	matches    []*match
+	round      int /* current round, 0 before the tournament starts */
+	rounds     int
+	champion   string
+	wordsDrawn int /* words chosen so far, offsetting the seed for each game */
+	created    time.Time
+}
+
+/* Serialisable form of a tournament, flushed alongside game state */
+type tournamentRecord struct {
+	TournamentID int       `json:"tournament_id"`
+	Name         string    `json:"name"`
+	Format       string    `json:"format"`
+	Seed         *int64    `json:"seed,omitempty"`
+	Players      []string  `json:"players"`
+	Matches      []match   `json:"matches"`
+	Round        int       `json:"round"`
+	Rounds       int       `json:"rounds"`
+	Champion     string    `json:"champion,omitempty"`
+	WordsDrawn   int       `json:"words_drawn"`
+	Created      time.Time `json:"created"`
+}
+
+/* Map to store tournaments, and the tournament each match game belongs to */
+var (
+	tournamentsMux   sync.RWMutex
+	tournaments      = make(map[int]*tournament)
+	nextTournamentID int
+	matchGames       = make(map[int]*tournament)
+)
+
+/* Creates a tournament open for registration */
+func newTournament(name, format string, players []string, seed *int64) (*tournament, error) {
+	t := &tournament{name: name, format: format, seed: seed, created: time.Now()}
+	for _, player := range players {
+		if err := t.Register(player); err != nil {
+			return nil, err
+		}
+	}
+
+	tournamentsMux.Lock()
+	t.id = nextTournamentID
+	tournaments[t.id] = t
+	nextTournamentID++
+	tournamentsMux.Unlock()
+
+	return t, nil
+}
+
+/* Looks up a tournament by ID, reporting whether it exists */
+func findTournament(id int) (*tournament, bool) {
+	tournamentsMux.RLock()
+	defer tournamentsMux.RUnlock()
+
+	t, ok := tournaments[id]
+	return t, ok
+}
+
+/* Returns all tournaments ordered by ID */
+func sortedTournaments() []*tournament {
+	tournamentsMux.RLock()
+	ts := make([]*tournament, 0, len(tournaments))
+	for _, t := range tournaments {
+		ts = append(ts, t)
+	}
+	tournamentsMux.RUnlock()
+
+	sort.Slice(ts, func(i, j int) bool { return ts[i].id < ts[j].id })
+	return ts
+}
+
+/* Adds a player before the tournament starts */
+func (t *tournament) Register(name string) error {
+	if name == "" {
+		return errors.New("Username is required")
+	}
+	if isBot(name) {
+		return errors.New("Bots cannot enter tournaments")
+	}
+	if t.round > 0 {
+		return errors.New("Tournament has already started")
+	}
+	for _, player := range t.players {
+		if player == name {
+			return fmt.Errorf("User %s is already registered", name)
+		}
+	}
+	t.players = append(t.players, name)
+	return nil
+}
+
+/* Draws up the first round and creates its games */
+func (t *tournament) Start() error {
+	if t.round > 0 {
+		return errors.New("Tournament has already started")
+	}
+	if len(t.players) < 2 {
+		return errors.New("Tournament needs at least 2 players")
+	}
+	/* Check for words before drawing up a round which could not be played */
+	if _, err := words.Nth(0); err != nil {
+		return err
+	}
+
+	switch t.format {
+	case roundRobin:
+		t.matches = roundRobinMatches(t.players)
+		t.rounds = t.matches[len(t.matches)-1].Round
+	case knockout:
+		t.matches = pairUp(1, t.players)
+		for n := 1; n < len(t.players); n *= 2 {
+			t.rounds++
+		}
+	}
+
+	return t.startRound(1)
+}
+
+/* Schedules every player against every other using the circle method, */
+/* padding odd fields with a bye */
+func roundRobinMatches(players []string) []*match {
+	field := append([]string(nil), players...)
+	if len(field)%2 == 1 {
+		field = append(field, "")
+	}
+
+	var matches []*match
+	n := len(field)
+	for round := 1; round < n; round++ {
+		for i := 0; i < n/2; i++ {
+			matches = append(matches, newMatch(round, field[i], field[n-1-i]))
+		}
+
+		/* Rotate every player but the first one place */
+		field = append(field[:1], append([]string{field[n-1]}, field[1:n-1]...)...)
+	}
+	return matches
+}
+
+/* Pairs players in order, the last receiving a bye if there is an odd number */
+func pairUp(round int, players []string) []*match {
+	var matches []*match
+	for i := 0; i < len(players); i += 2 {
+		opponent := ""
+		if i+1 < len(players) {
+			opponent = players[i+1]
+		}
+		matches = append(matches, newMatch(round, players[i], opponent))
+	}
+	return matches
+}
+
+func newMatch(round int, a, b string) *match {
+	m := &match{Round: round, GameID: -1}
+	for _, player := range []string{a, b} {
+		if player != "" {
+			m.Players = append(m.Players, player)
+		}
+	}
+	return m
+}
+
+/* Chooses the next match word, reproducible when the tournament is seeded */
+func (t *tournament) word() (string, error) {
+	t.wordsDrawn++
+	if t.seed == nil {
+		return randomWord()
+	}
+	return seededWord(*t.seed + int64(t.wordsDrawn))
+}
+
+/* Creates a game for the match which only its players may guess */
+func (t *tournament) playMatch(m *match) error {
+	word, err := t.word()
+	if err != nil {
+		return err
+	}
+
+	m.GameID = newGame(word, m.Players...)
+	m.Games++
+
+	tournamentsMux.Lock()
+	matchGames[m.GameID] = t
+	tournamentsMux.Unlock()
+
+	gameEvents.Publish(gameEvent{GameID: m.GameID})
+	slog.Info("Tournament match started", "tournament_id", t.id, "round", m.Round, "players", m.Players, "game_id", m.GameID)
+	return nil
+}
+
+/* Starts a round, creating games for its matches and settling byes */
+func (t *tournament) startRound(round int) error {
+	t.round = round
+	for _, m := range t.matches {
+		if m.Round != round {
+			continue
+		}
+		if len(m.Players) == 1 {
+			m.Winner = m.Players[0]
+			m.Done = true
+			continue
+		}
+		if err := t.playMatch(m); err != nil {
+			return err
+		}
+	}
+
+	/* A round of byes alone is already complete */
+	return t.advance()
+}
+
+/* Records the outcome of a match game. A game cut short by a forfeit or an */
+/* admin, which only one of its players had guessed in, is a walkover for that */
+/* player. A drawn knockout match is replayed, up to maxMatchGames games, after */
+/* which the player drawn first in the pairing, the higher seed, goes through */
+func (t *tournament) matchOver(gameID int, winner string, cutShort bool, guessed []string) {
+	for _, m := range t.matches {
+		if m.GameID != gameID || m.Done {
+			continue
+		}
+
+		tournamentsMux.Lock()
+		delete(matchGames, gameID)
+		tournamentsMux.Unlock()
+
+		if !m.Has(winner) {
+			winner = ""
+		}
+
+		var showed []string
+		for _, player := range guessed {
+			if m.Has(player) {
+				showed = append(showed, player)
+			}
+		}
+		if winner == "" && cutShort && len(showed) == 1 {
+			winner = showed[0]
+			slog.Info("Tournament match awarded as a walkover", "tournament_id", t.id, "round", m.Round, "players", m.Players, "winner", winner)
+		}
+
+		if winner == "" && t.format == knockout {
+			if m.Games < maxMatchGames {
+				if err := t.playMatch(m); err != nil {
+					slog.Error("Failed to replay tournament match", "tournament_id", t.id, "round", m.Round, "players", m.Players, "error", err)
+				}
+				return
+			}
+			winner = m.Players[0]
+			slog.Info("Tournament match drawn too often, higher seed goes through", "tournament_id", t.id, "round", m.Round, "players", m.Players, "games", m.Games, "winner", winner)
+		}
+
+		m.Winner = winner
+		m.Done = true
+		slog.Info("Tournament match finished", "tournament_id", t.id, "round", m.Round, "players", m.Players, "winner", winner)
+
+		if err := t.advance(); err != nil {
+			slog.Error("Failed to start next tournament round", "tournament_id", t.id, "round", t.round, "error", err)
+		}
+		return
+	}
+}
+
+func (m *match) Has(name string) bool {
+	for _, player := range m.Players {
+		if player == name {
+			return true
+		}
+	}
+	return false
+}
+
+/* Moves on to the next round once every match in the current one is decided */
+func (t *tournament) advance() error {
+	var winners []string
+	for _, m := range t.matches {
+		if m.Round != t.round {
+			continue
+		}
+		if !m.Done {
+			return nil
+		}
+		winners = append(winners, m.Winner)
+	}
+
+	switch {
+	case t.format == knockout && len(winners) > 1:
+		t.matches = append(t.matches, pairUp(t.round+1, winners)...)
+		return t.startRound(t.round + 1)
+	case t.format == knockout:
+		t.champion = winners[0]
+	case t.round < t.rounds:
+		return t.startRound(t.round + 1)
+	default:
+		t.champion = t.Standings()[0].Username
+	}
+
+	slog.Info("Tournament finished", "tournament_id", t.id, "champion", t.champion)
+	return nil
+}
+
+/* Tallies results from decided matches, byes aside, best record first */
+func (t *tournament) Standings() []*hangmanv1.Standing {
+	byName := make(map[string]*hangmanv1.Standing)
+	standings := make([]*hangmanv1.Standing, 0, len(t.players))
+	for _, player := range t.players {
+		st := &hangmanv1.Standing{Username: player}
+		byName[player] = st
+		standings = append(standings, st)
+	}
+
+	for _, m := range t.matches {
+		if !m.Done || len(m.Players) < 2 {
+			continue
+		}
+		for _, player := range m.Players {
+			st := byName[player]
+			st.Played++
+			switch m.Winner {
+			case "":
+				st.Draws++
+			case player:
+				st.Wins++
+			default:
+				st.Losses++
+				st.Eliminated = t.format == knockout
+			}
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Draws != b.Draws {
+			return a.Draws > b.Draws
+		}
+		return a.Losses < b.Losses
+	})
+	return standings
+}
+
+/* Advances the tournament, if any, which a just-finished game decides */
+func tournamentGameOver(pGame *gameStore) {
+	if pGame.daily != "" {
+		return
+	}
+
+	tournamentsMux.RLock()
+	t, ok := matchGames[pGame.gameID]
+	tournamentsMux.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	/* A game over with turns left and no player winning was forfeited or ended by an admin */
+	t.mux.Lock()
+	t.matchOver(pGame.gameID, pGame.winner, pGame.turns > 0, pGame.players)
+	t.mux.Unlock()
+}
+
+/* Converts tournament into a record suitable for persistent storage */
+func (t *tournament) Record() tournamentRecord {
+	rec := tournamentRecord{
+		TournamentID: t.id,
+		Name:         t.name,
+		Format:       t.format,
+		Seed:         t.seed,
+		Players:      append([]string(nil), t.players...),
+		Round:        t.round,
+		Rounds:       t.rounds,
+		Champion:     t.champion,
+		WordsDrawn:   t.wordsDrawn,
+		Created:      t.created,
+	}
+	for _, m := range t.matches {
+		rec.Matches = append(rec.Matches, *m)
+	}
+	return rec
+}
+
+/* Snapshots every tournament for flushing to storage */
+func snapshotTournaments() (int, []tournamentRecord) {
+	tournamentsMux.RLock()
+	next := nextTournamentID
+	tournamentsMux.RUnlock()
+
+	var recs []tournamentRecord
+	for _, t := range sortedTournaments() {
+		t.mux.Lock()
+		recs = append(recs, t.Record())
+		t.mux.Unlock()
+	}
+	return next, recs
+}
+
+/* Replaces held tournaments with those restored from storage */
+func restoreTournaments(next int, recs []tournamentRecord) {
+	tournamentsMux.Lock()
+	defer tournamentsMux.Unlock()
+
+	tournaments = make(map[int]*tournament)
+	matchGames = make(map[int]*tournament)
+	nextTournamentID = next
+
+	for _, rec := range recs {
+		t := &tournament{
+			id:         rec.TournamentID,
+			name:       rec.Name,
+			format:     rec.Format,
+			seed:       rec.Seed,
+			players:    rec.Players,
+			round:      rec.Round,
+			rounds:     rec.Rounds,
+			champion:   rec.Champion,
+			wordsDrawn: rec.WordsDrawn,
+			created:    rec.Created,
+		}
+		for i := range rec.Matches {
+			m := rec.Matches[i]
+			t.matches = append(t.matches, &m)
+			if !m.Done && m.GameID >= 0 {
+				matchGames[m.GameID] = t
+			}
+		}
+
+		tournaments[t.id] = t
+		if t.id >= nextTournamentID {
+			nextTournamentID = t.id + 1
+		}
+	}
+}
+
+/* Converts tournament into its API view */
+func tournamentProto(t *tournament) *hangmanv1.Tournament {
+	res := &hangmanv1.Tournament{
+		TournamentId: int32(t.id),
+		Name:         t.name,
+		Format:       hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN,
+		Players:      append([]string(nil), t.players...),
+		CurrentRound: int32(t.round),
+		TotalRounds:  int32(t.rounds),
+		Finished:     t.champion != "",
+		Champion:     t.champion,
+	}
+	if t.format == knockout {
+		res.Format = hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_KNOCKOUT
+	}
+
+	for _, m := range t.matches {
+		res.Matches = append(res.Matches, &hangmanv1.Match{
+			Round:       int32(m.Round),
+			Players:     append([]string(nil), m.Players...),
+			GameId:      int32(m.GameID),
+			Finished:    m.Done,
+			Winner:      m.Winner,
+			GamesPlayed: int32(m.Games),
+		})
+	}
+	return res
+}
+
+/* Looks up a tournament for a handler, locking it until the returned func is called */
+func lockTournament(id int32) (*tournament, func(), error) {
+	t, ok := findTournament(int(id))
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "Tournament %d does not exist", id)
+	}
+	t.mux.Lock()
+	return t, t.mux.Unlock, nil
+}
+
+func (*server) CreateTournament(ctx context.Context, req *hangmanv1.CreateTournamentRequest) (*hangmanv1.CreateTournamentResponse, error) {
+	loggerFrom(ctx).Debug("CreateTournament function was invoked", "req", req)
+
+	var format string
+	switch req.GetFormat() {
+	case hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN:
+		format = roundRobin
+	case hangmanv1.TournamentFormat_TOURNAMENT_FORMAT_KNOCKOUT:
+		format = knockout
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Tournament format must be round robin or knockout")
+	}
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Tournament name is required")
+	}
+
+	for _, player := range req.GetPlayers() {
+		if isBanned(player) {
+			return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", player)
+		}
+	}
+
+	t, err := newTournament(req.GetName(), format, req.GetPlayers(), req.Seed)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	loggerFrom(ctx).Info("Tournament created", "tournament_id", t.id, "format", format, "players", len(t.players))
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return &hangmanv1.CreateTournamentResponse{Tournament: tournamentProto(t)}, nil
+}
+
+func (*server) RegisterPlayer(ctx context.Context, req *hangmanv1.RegisterPlayerRequest) (*hangmanv1.RegisterPlayerResponse, error) {
+	loggerFrom(ctx).Debug("RegisterPlayer function was invoked", "req", req)
+
+	if isBanned(req.GetUsername()) {
+		return nil, status.Errorf(codes.PermissionDenied, "User %s is banned", req.GetUsername())
+	}
+
+	t, unlock, err := lockTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := t.Register(req.GetUsername()); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	loggerFrom(ctx).Info("Tournament player registered", "tournament_id", t.id, "username", req.GetUsername())
+
+	return &hangmanv1.RegisterPlayerResponse{Tournament: tournamentProto(t)}, nil
+}
+
+func (*server) StartTournament(ctx context.Context, req *hangmanv1.StartTournamentRequest) (*hangmanv1.StartTournamentResponse, error) {
+	loggerFrom(ctx).Debug("StartTournament function was invoked", "req", req)
+
+	t, unlock, err := lockTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := t.Start(); err != nil {
+		/* Errors from the word source already carry their status */
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	loggerFrom(ctx).Info("Tournament started", "tournament_id", t.id, "rounds", t.rounds)
+
+	return &hangmanv1.StartTournamentResponse{Tournament: tournamentProto(t)}, nil
+}
+
+func (*server) GetTournament(ctx context.Context, req *hangmanv1.GetTournamentRequest) (*hangmanv1.GetTournamentResponse, error) {
+	loggerFrom(ctx).Debug("GetTournament function was invoked", "req", req)
+
+	t, unlock, err := lockTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &hangmanv1.GetTournamentResponse{Tournament: tournamentProto(t)}, nil
+}
+
+func (*server) ListTournaments(ctx context.Context, req *hangmanv1.ListTournamentsRequest) (*hangmanv1.ListTournamentsResponse, error) {
+	loggerFrom(ctx).Debug("ListTournaments function was invoked")
+
+	res := &hangmanv1.ListTournamentsResponse{}
+	for _, t := range sortedTournaments() {
+		t.mux.Lock()
+		res.Tournaments = append(res.Tournaments, tournamentProto(t))
+		t.mux.Unlock()
+	}
+
+	return res, nil
+}
+
+func (*server) GetStandings(ctx context.Context, req *hangmanv1.GetStandingsRequest) (*hangmanv1.GetStandingsResponse, error) {
+	loggerFrom(ctx).Debug("GetStandings function was invoked", "req", req)
+
+	t, unlock, err := lockTournament(req.GetTournamentId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &hangmanv1.GetStandingsResponse{Standings: t.Standings()}, nil
+}
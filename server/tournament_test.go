@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+)
+
+/* Plays a game to its end as player, guessing the word to win it or */
+/* missing every turn to lose it */
+func playOut(t *testing.T, gameID int, player string, win bool) {
+	t.Helper()
+
+	pGame, ok := findGame(gameID)
+	if !ok {
+		t.Fatalf("Game %d does not exist", gameID)
+	}
+	pGame.mux.Lock()
+	word := strings.Join(pGame.playWord, "")
+	pGame.mux.Unlock()
+
+	var letters []string
+	for _, r := range "abcdefghijklmnopqrstuvwxyz" {
+		if strings.ContainsRune(word, r) == win {
+			letters = append(letters, string(r))
+		}
+	}
+
+	srv := &server{}
+	for _, letter := range letters {
+		res, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(gameID), Letter: letter, Username: player})
+		if err != nil {
+			t.Fatalf("Guess(%s) by %s: %v", letter, player, err)
+		}
+		if !res.Game.Active {
+			return
+		}
+	}
+	t.Fatalf("Game %d still active after %s played %q", gameID, player, letters)
+}
+
+/* Starts a tournament between players, failing the test on error */
+func startTournament(t *testing.T, format string, players ...string) *tournament {
+	t.Helper()
+
+	tm, err := newTournament("test", format, players, nil)
+	if err != nil {
+		t.Fatalf("newTournament: %v", err)
+	}
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if err := tm.Start(); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	return tm
+}
+
+/* Returns the undecided match between the two players in the current round */
+func currentMatch(t *testing.T, tm *tournament, a, b string) *match {
+	t.Helper()
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	for _, m := range tm.matches {
+		if m.Round == tm.round && !m.Done && m.Has(a) && m.Has(b) {
+			return m
+		}
+	}
+	t.Fatalf("No undecided match between %s and %s in round %d", a, b, tm.round)
+	return nil
+}
+
+func TestDeletingMatchGameDrawsRoundRobinMatch(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	tm := startTournament(t, roundRobin, "a", "b")
+	m := currentMatch(t, tm, "a", "b")
+
+	if _, err := srv.DeleteGame(context.Background(), &hangmanv1.DeleteGameRequest{GameId: int32(m.GameID)}); err != nil {
+		t.Fatalf("DeleteGame: %v", err)
+	}
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if !m.Done || m.Winner != "" {
+		t.Errorf("Match after its game was deleted: done %v, winner %q, want a draw", m.Done, m.Winner)
+	}
+	if tm.champion == "" {
+		t.Errorf("Tournament did not finish once its only match was settled")
+	}
+}
+
+func TestDeletingMatchGameReplaysKnockoutMatch(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	tm := startTournament(t, knockout, "a", "b")
+	m := currentMatch(t, tm, "a", "b")
+	deleted := m.GameID
+
+	if _, err := srv.DeleteGame(context.Background(), &hangmanv1.DeleteGameRequest{GameId: int32(deleted)}); err != nil {
+		t.Fatalf("DeleteGame: %v", err)
+	}
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if m.Done || m.GameID == deleted || m.Games != 2 {
+		t.Fatalf("Match after its game was deleted: done %v, game %d (deleted %d), games %d, want a replay", m.Done, m.GameID, deleted, m.Games)
+	}
+	if _, ok := findGame(m.GameID); !ok {
+		t.Errorf("Replay game %d does not exist", m.GameID)
+	}
+}
+
+/* Finds a player's standing, failing the test if they have none */
+func standing(t *testing.T, tm *tournament, name string) *hangmanv1.Standing {
+	t.Helper()
+
+	for _, st := range tm.Standings() {
+		if st.Username == name {
+			return st
+		}
+	}
+	t.Fatalf("No standing for %s", name)
+	return nil
+}
+
+func TestRoundRobinPlaysEveryPairingOnce(t *testing.T) {
+	resetServer(t)
+
+	tm := startTournament(t, roundRobin, "a", "b", "c")
+
+	/* With three players one sits out each round, so there are three rounds */
+	if tm.rounds != 3 {
+		t.Fatalf("Rounds = %d, want 3", tm.rounds)
+	}
+
+	pairings := make(map[string]int)
+	for _, m := range tm.matches {
+		if len(m.Players) == 2 {
+			pairings[strings.Join(m.Players, " v ")]++
+		}
+	}
+	if len(pairings) != 3 {
+		t.Fatalf("Pairings = %v, want each pair of players once", pairings)
+	}
+
+	/* a beats everyone and b beats c, whichever order the rounds come in */
+	results := map[[2]string]string{{"a", "b"}: "a", {"a", "c"}: "a", {"b", "c"}: "b"}
+	for round := 1; round <= 3; round++ {
+		for pair, winner := range results {
+			tm.mux.Lock()
+			var m *match
+			for _, cand := range tm.matches {
+				if cand.Round == tm.round && !cand.Done && cand.Has(pair[0]) && cand.Has(pair[1]) {
+					m = cand
+				}
+			}
+			tm.mux.Unlock()
+
+			if m != nil {
+				playOut(t, m.GameID, winner, true)
+			}
+		}
+	}
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if tm.champion != "a" {
+		t.Errorf("Champion = %q, want a", tm.champion)
+	}
+	for name, want := range map[string][3]int32{"a": {2, 0, 0}, "b": {1, 0, 1}, "c": {0, 0, 2}} {
+		st := standing(t, tm, name)
+		if got := [3]int32{st.Wins, st.Draws, st.Losses}; got != want || st.Played != 2 {
+			t.Errorf("%s won, drew and lost %v of %d, want %v of 2", name, got, st.Played, want)
+		}
+	}
+}
+
+func TestKnockoutGivesByeAndAdvancesWinners(t *testing.T) {
+	resetServer(t)
+
+	tm := startTournament(t, knockout, "a", "b", "c")
+
+	tm.mux.Lock()
+	var bye *match
+	for _, m := range tm.matches {
+		if m.Round == 1 && len(m.Players) == 1 {
+			bye = m
+		}
+	}
+	tm.mux.Unlock()
+
+	if bye == nil || bye.Players[0] != "c" || !bye.Done || bye.Winner != "c" {
+		t.Fatalf("Round 1 bye = %+v, want c through without playing", bye)
+	}
+
+	playOut(t, currentMatch(t, tm, "a", "b").GameID, "a", true)
+
+	/* The winner meets the player who had the bye */
+	final := currentMatch(t, tm, "a", "c")
+	if final.Round != 2 {
+		t.Fatalf("a v c played in round %d, want 2", final.Round)
+	}
+	playOut(t, final.GameID, "c", true)
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if tm.champion != "c" {
+		t.Errorf("Champion = %q, want c", tm.champion)
+	}
+	if st := standing(t, tm, "c"); st.Played != 1 || st.Wins != 1 || st.Eliminated {
+		t.Errorf("c played %d, won %d, eliminated %v; want the bye left out of the record", st.Played, st.Wins, st.Eliminated)
+	}
+	for _, name := range []string{"a", "b"} {
+		if !standing(t, tm, name).Eliminated {
+			t.Errorf("%s not marked eliminated", name)
+		}
+	}
+}
+
+func TestKnockoutReplaysDrawnMatch(t *testing.T) {
+	resetServer(t)
+
+	tm := startTournament(t, knockout, "a", "b")
+	m := currentMatch(t, tm, "a", "b")
+	first := m.GameID
+
+	/* Nobody reveals the word, so the match is played again with a new game */
+	playOut(t, first, "a", false)
+
+	tm.mux.Lock()
+	replay, games, done := m.GameID, m.Games, m.Done
+	tm.mux.Unlock()
+
+	if done || replay == first || games != 2 {
+		t.Fatalf("Drawn match: done %v, game %d (first %d), games %d, want a replay", done, replay, first, games)
+	}
+
+	playOut(t, replay, "b", true)
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if !m.Done || m.Winner != "b" || tm.champion != "b" {
+		t.Errorf("After the replay: done %v, winner %q, champion %q, want b", m.Done, m.Winner, tm.champion)
+	}
+	if st := standing(t, tm, "a"); st.Draws != 0 || st.Losses != 1 {
+		t.Errorf("a drew %d and lost %d, want the replayed draw left out", st.Draws, st.Losses)
+	}
+}
+
+func TestKnockoutAwardsForfeitedMatchToPlayerWhoGuessed(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	tm := startTournament(t, knockout, "a", "b")
+	m := currentMatch(t, tm, "a", "b")
+
+	/* a turns up and guesses, b never does */
+	if _, err := srv.Guess(context.Background(), &hangmanv1.GuessRequest{GameId: int32(m.GameID), Letter: "q", Username: "a"}); err != nil {
+		t.Fatalf("Guess: %v", err)
+	}
+
+	pGame, _ := findGame(m.GameID)
+	pGame.mux.Lock()
+	pGame.lastActivity = time.Now().Add(-time.Hour)
+	pGame.mux.Unlock()
+
+	j := &janitor{interval: time.Minute, idleTimeout: time.Minute, retention: time.Hour, store: newFileStorage("", "")}
+	j.sweep(time.Now())
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if !m.Done || m.Winner != "a" || m.Games != 1 || tm.champion != "a" {
+		t.Errorf("Forfeited match: done %v, winner %q, games %d, champion %q, want a walkover for a", m.Done, m.Winner, m.Games, tm.champion)
+	}
+}
+
+func TestKnockoutSettlesMatchAfterTooManyReplays(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+
+	tm := startTournament(t, knockout, "a", "b")
+	m := currentMatch(t, tm, "a", "b")
+
+	/* Neither player ever guesses, so each forced end is a draw */
+	for i := 0; i < maxMatchGames; i++ {
+		tm.mux.Lock()
+		gameID := m.GameID
+		tm.mux.Unlock()
+
+		if _, err := srv.EndGame(context.Background(), &hangmanv1.EndGameRequest{GameId: int32(gameID)}); err != nil {
+			t.Fatalf("EndGame: %v", err)
+		}
+	}
+
+	tm.mux.Lock()
+	defer tm.mux.Unlock()
+
+	if !m.Done || m.Winner != "a" || m.Games != maxMatchGames || tm.champion != "a" {
+		t.Errorf("Match drawn %d times: done %v, winner %q, games %d, champion %q, want the higher seed a through", maxMatchGames, m.Done, m.Winner, m.Games, tm.champion)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Browser front-end, served from the gateway address */
+//go:embed web
+var webFiles embed.FS
+
+func webHandler() http.Handler {
+	sub, err := fs.Sub(webFiles, "web")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServer(http.FS(sub))
+}
+
+/* Streams game updates to the browser as server-sent events. With ?game=N */
+/* only that game is streamed, otherwise every game is. A private room is only */
+/* streamed to its players, named with ?username= */
+func serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	filter := -1
+	if g := r.URL.Query().Get("game"); g != "" {
+		id, err := strconv.Atoi(g)
+		if err != nil {
+			http.Error(w, "Invalid game", http.StatusBadRequest)
+			return
+		}
+		filter = id
+	}
+	username := r.URL.Query().Get("username")
+
+	if filter >= 0 {
+		if err := mayView(filter, username); status.Code(err) == codes.PermissionDenied {
+			http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+			return
+		}
+	}
+
+	ch := gameEvents.Subscribe()
+	defer gameEvents.Unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	/* Send current state up front so the page never waits for the first change */
+	if filter >= 0 {
+		writeGameEvent(w, gameEvent{GameID: filter})
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-stopping.Done():
+			return
+		case ev := <-ch:
+			if ev.Chat != nil || (filter >= 0 && ev.GameID != filter) {
+				continue
+			}
+			/* Private rooms are only streamed to their players, and only by ID */
+			if pGame, ok := findGame(ev.GameID); ok && pGame.IsPrivate() && (filter < 0 || mayView(ev.GameID, username) != nil) {
+				continue
+			}
+			writeGameEvent(w, ev)
+			flusher.Flush()
+		}
+	}
+}
+
+/* Writes a single "game" or "deleted" event carrying the game's JSON view */
+func writeGameEvent(w http.ResponseWriter, ev gameEvent) {
+	pGame, ok := findGame(ev.GameID)
+	if ev.Deleted || !ok {
+		fmt.Fprintf(w, "event: deleted\ndata: {\"gameId\":%d}\n\n", ev.GameID)
+		return
+	}
+
+	pGame.mux.Lock()
+	b := gameJSON(playerGame(pGame))
+	pGame.mux.Unlock()
+
+	fmt.Fprintf(w, "event: game\ndata: %s\n\n", b)
+}
@@ -0,0 +1,168 @@
+// Browser client for HangmanGo, speaking to the REST gateway and following
+// live updates over server-sent events.
+"use strict";
+
+const letters = "abcdefghijklmnopqrstuvwxyz".split("");
+const games = new Map();
+let current = null;
+let boardEvents = null;
+
+const $ = (id) => document.getElementById(id);
+
+async function api(method, path, body) {
+  const res = await fetch(path, {
+    method: method,
+    headers: { "Content-Type": "application/json" },
+    body: body === undefined ? undefined : JSON.stringify(body),
+  });
+  const data = await res.json();
+  if (!res.ok) {
+    throw new Error(data.message || res.statusText);
+  }
+  return data;
+}
+
+function gameStatus(g) {
+  if (g.active) {
+    return "Playing";
+  }
+  if (g.winner && g.winner !== "N/A") {
+    return g.winner === "Forfeit" ? "Forfeited" : "Won by " + g.winner;
+  }
+  return g.turns === 0 ? "Lost" : "Ended";
+}
+
+function renderList() {
+  const body = $("game-list");
+  body.replaceChildren();
+  const ids = [...games.keys()].sort((a, b) => a - b);
+  for (const id of ids) {
+    const g = games.get(id);
+    const row = document.createElement("tr");
+    row.className = id === current ? "selected" : "";
+    for (const text of [g.gameId, g.wordState, g.turns, gameStatus(g)]) {
+      const cell = document.createElement("td");
+      cell.textContent = text;
+      row.appendChild(cell);
+    }
+    row.addEventListener("click", () => openGame(id));
+    body.appendChild(row);
+  }
+}
+
+function renderBoard() {
+  const g = games.get(current);
+  if (!g) {
+    $("board").hidden = true;
+    return;
+  }
+  $("board").hidden = false;
+  $("board-id").textContent = g.gameId;
+  $("word").textContent = g.wordState.split("").join(" ");
+  $("status").textContent = gameStatus(g) + " - " + g.turns + " turns left";
+
+  // Scale wrong guesses onto the drawn parts so any turn budget fills the gallows
+  const parts = document.querySelectorAll("#gallows .part");
+  const wrong = g.maxTurns - g.turns;
+  const shown = g.maxTurns > 0 ? Math.round((wrong / g.maxTurns) * parts.length) : 0;
+  parts.forEach((p, i) => p.classList.toggle("shown", i < shown));
+
+  const hits = new Set(g.hits || []);
+  const misses = new Set(g.misses || []);
+  for (const btn of $("keyboard").children) {
+    const letter = btn.dataset.letter;
+    btn.classList.toggle("hit", hits.has(letter));
+    btn.classList.toggle("miss", misses.has(letter));
+    btn.disabled = hits.has(letter) || misses.has(letter) || !g.active;
+  }
+}
+
+function openGame(id) {
+  current = id;
+  $("detail").replaceChildren();
+  if (boardEvents) {
+    boardEvents.close();
+  }
+  const username = encodeURIComponent($("username").value || "guest");
+  boardEvents = new EventSource("/events?game=" + id + "&username=" + username);
+  boardEvents.addEventListener("game", (e) => {
+    const g = JSON.parse(e.data);
+    games.set(g.gameId, g);
+    renderList();
+    renderBoard();
+  });
+  renderList();
+  renderBoard();
+}
+
+async function guess(letter) {
+  try {
+    const res = await api("POST", "/games/" + current + "/guesses", {
+      letter: letter,
+      username: $("username").value || "guest",
+    });
+    games.set(res.game.gameId, res.game);
+    const detail = $("detail");
+    detail.replaceChildren();
+    for (const line of res.detail) {
+      const li = document.createElement("li");
+      li.textContent = line.trim();
+      detail.appendChild(li);
+    }
+    renderList();
+    renderBoard();
+  } catch (err) {
+    alert(err.message);
+  }
+}
+
+function buildKeyboard() {
+  for (const letter of letters) {
+    const btn = document.createElement("button");
+    btn.textContent = letter.toUpperCase();
+    btn.dataset.letter = letter;
+    btn.addEventListener("click", () => guess(letter));
+    $("keyboard").appendChild(btn);
+  }
+}
+
+async function init() {
+  buildKeyboard();
+
+  const res = await api("GET", "/games");
+  for (const g of res.games) {
+    games.set(g.gameId, g);
+  }
+  renderList();
+
+  $("new-game").addEventListener("click", async () => {
+    try {
+      const res = await api("POST", "/games", {});
+      openGame(res.gameId);
+    } catch (err) {
+      alert(err.message);
+    }
+  });
+
+  // Keep the game list current as other players create and play games
+  const listEvents = new EventSource("/events");
+  listEvents.addEventListener("game", (e) => {
+    const g = JSON.parse(e.data);
+    games.set(g.gameId, g);
+    renderList();
+    if (g.gameId === current) {
+      renderBoard();
+    }
+  });
+  listEvents.addEventListener("deleted", (e) => {
+    const id = JSON.parse(e.data).gameId;
+    games.delete(id);
+    if (id === current) {
+      current = null;
+    }
+    renderList();
+    renderBoard();
+  });
+}
+
+init();
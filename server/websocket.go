@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	wsPingInterval = 30 * time.Second
+	wsWriteTimeout = 10 * time.Second
+)
+
+/* Numbers the guest name given to each connection which plays without a username */
+var wsGuests atomic.Uint64
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+/* Message sent by a WebSocket client. Type is "subscribe", "guess" or "chat" */
+type wsRequest struct {
+	Type     string `json:"type"`
+	GameID   int    `json:"gameId"`
+	Letter   string `json:"letter,omitempty"`
+	Username string `json:"username,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Reaction string `json:"reaction,omitempty"`
+}
+
+/* Message pushed to a WebSocket client. Type is "game", "guess", "chat", "deleted" or "error" */
+type wsResponse struct {
+	Type    string          `json:"type"`
+	GameID  int             `json:"gameId"`
+	Game    json.RawMessage `json:"game,omitempty"`
+	Detail  []string        `json:"detail,omitempty"`
+	Chat    *chatMessage    `json:"chat,omitempty"`
+	Message string          `json:"message,omitempty"`
+}
+
+/* The game a connection is subscribed to, -1 for none, and who subscribed */
+type wsSubscription struct {
+	mux      sync.Mutex
+	game     int
+	username string
+}
+
+func (s *wsSubscription) Set(game int, username string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.game, s.username = game, username
+}
+
+func (s *wsSubscription) Get() (int, string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.game, s.username
+}
+
+/* Persistent game channel: clients subscribe to one game at a time, send */
+/* guesses and chat, and receive board updates and chat as they happen */
+type wsHandler struct {
+	client hangmanv1.HangmanServiceClient
+}
+
+func (h *wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	/* Optionally subscribe straight away with ?game=N, and ?username= for a private room */
+	sub := &wsSubscription{game: -1}
+	if g := r.URL.Query().Get("game"); g != "" {
+		id, err := strconv.Atoi(g)
+		if err != nil {
+			http.Error(w, "Invalid game", http.StatusBadRequest)
+			return
+		}
+		username := r.URL.Query().Get("username")
+		if err := mayView(id, username); status.Code(err) == codes.PermissionDenied {
+			http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+			return
+		}
+		sub.Set(id, username)
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		slog.Debug("WebSocket upgrade failed", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(forwardedFor(r.Context(), r))
+	defer cancel()
+
+	/* Players who give no username each get their own guest name, and so their */
+	/* own user rate limit rather than one shared by every anonymous player */
+	guest := "guest-" + strconv.FormatUint(wsGuests.Add(1), 10)
+
+	/* Shutdown does not track hijacked connections, so close this one when it begins */
+	go func() {
+		select {
+		case <-stopping.Done():
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "Server is shutting down"), time.Now().Add(wsWriteTimeout))
+			conn.Close()
+		case <-ctx.Done():
+		}
+	}()
+
+	out := make(chan wsResponse, 16)
+	go h.writeLoop(ctx, conn, out)
+
+	ch := gameEvents.Subscribe()
+	defer gameEvents.Unsubscribe(ch)
+	go h.eventLoop(ctx, ch, sub, out)
+
+	if id, username := sub.Get(); id >= 0 {
+		h.send(ctx, out, h.gameState(id, username))
+	}
+
+	/* Read until the client goes away, handling each request in turn */
+	for {
+		var req wsRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+
+		switch req.Type {
+		case "subscribe":
+			/* Only a private room's players may follow it */
+			if err := mayView(req.GameID, req.Username); status.Code(err) == codes.PermissionDenied {
+				h.send(ctx, out, wsResponse{Type: "error", GameID: req.GameID, Message: status.Convert(err).Message()})
+				continue
+			}
+			sub.Set(req.GameID, req.Username)
+			h.send(ctx, out, h.gameState(req.GameID, req.Username))
+		case "guess":
+			id, _ := sub.Get()
+			h.send(ctx, out, h.guess(ctx, id, guest, req))
+		case "chat":
+			id, _ := sub.Get()
+			if res := h.chat(id, guest, req); res != nil {
+				h.send(ctx, out, *res)
+			}
+		default:
+			h.send(ctx, out, wsResponse{Type: "error", Message: "Unknown request type " + strconv.Quote(req.Type)})
+		}
+	}
+}
+
+/* Passes on the address the client connected from as x-forwarded-for, as the */
+/* gateway does, so calls made for it are rate limited as that client rather */
+/* than sharing the loopback address of this server's own connection */
+func forwardedFor(ctx context.Context, r *http.Request) context.Context {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return ctx
+	}
+
+	fwd := host
+	if prior := r.Header.Get("X-Forwarded-For"); prior != "" {
+		fwd = prior + ", " + host
+	}
+	return metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", fwd)
+}
+
+func (h *wsHandler) send(ctx context.Context, out chan<- wsResponse, res wsResponse) {
+	select {
+	case out <- res:
+	case <-ctx.Done():
+	}
+}
+
+/* Sole writer to the connection, also keeping it alive with pings */
+func (h *wsHandler) writeLoop(ctx context.Context, conn *websocket.Conn, out <-chan wsResponse) {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case res := <-out:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := conn.WriteJSON(res); err != nil {
+				conn.Close()
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+/* Forwards updates and chat for the subscribed game */
+func (h *wsHandler) eventLoop(ctx context.Context, ch <-chan gameEvent, sub *wsSubscription, out chan<- wsResponse) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-ch:
+			id, username := sub.Get()
+			if ev.GameID != id {
+				continue
+			}
+
+			switch {
+			case ev.Chat != nil:
+				h.send(ctx, out, wsResponse{Type: "chat", GameID: ev.GameID, Chat: ev.Chat})
+			case ev.Deleted:
+				h.send(ctx, out, wsResponse{Type: "deleted", GameID: ev.GameID})
+			default:
+				h.send(ctx, out, h.gameState(ev.GameID, username))
+			}
+		}
+	}
+}
+
+/* Reads the game directly rather than through the gRPC server, so board updates */
+/* pushed for other players' guesses never draw on this client's rate limits */
+func (h *wsHandler) gameState(id int, username string) wsResponse {
+	if err := mayView(id, username); err != nil {
+		return wsResponse{Type: "error", GameID: id, Message: status.Convert(err).Message()}
+	}
+
+	pGame, ok := findGame(id)
+	if !ok {
+		return wsResponse{Type: "error", GameID: id, Message: fmt.Sprintf("Game %d does not exist", id)}
+	}
+
+	pGame.mux.Lock()
+	g := gameJSON(playerGame(pGame))
+	pGame.mux.Unlock()
+
+	return wsResponse{Type: "game", GameID: id, Game: g}
+}
+
+/* Guesses go through the gRPC server so they take exactly the same path as any other client */
+func (h *wsHandler) guess(ctx context.Context, id int, guest string, req wsRequest) wsResponse {
+	if id < 0 {
+		return wsResponse{Type: "error", Message: "Subscribe to a game before guessing"}
+	}
+
+	username := req.Username
+	if username == "" {
+		username = guest
+	}
+
+	res, err := h.client.Guess(ctx, &hangmanv1.GuessRequest{GameId: int32(id), Letter: req.Letter, Username: username})
+	if err != nil {
+		return wsResponse{Type: "error", GameID: id, Message: status.Convert(err).Message()}
+	}
+	return wsResponse{Type: "guess", GameID: id, Game: gameJSON(res.Game), Detail: res.Detail}
+}
+
+/* Posts a chat message or reaction to the subscribed game, returning an error response if refused */
+func (h *wsHandler) chat(id int, guest string, req wsRequest) *wsResponse {
+	if id < 0 {
+		return &wsResponse{Type: "error", Message: "Subscribe to a game before chatting"}
+	}
+
+	username := req.Username
+	if username == "" {
+		username = guest
+	}
+
+	if _, err := postChat(id, username, req.Text, req.Reaction); err != nil {
+		return &wsResponse{Type: "error", GameID: id, Message: status.Convert(err).Message()}
+	}
+	return nil
+}
+
+/* Encodes a game the same way as the REST gateway */
+func gameJSON(g *hangmanv1.Game) json.RawMessage {
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(g)
+	if err != nil {
+		return nil
+	}
+	return b
+}
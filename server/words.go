@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tjarratt/babble"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Refused when a word is needed but the word source holds none */
+var errNoWords = status.Error(codes.FailedPrecondition, "No words are loaded, reload the word list")
+
+/* Source of secret words for new games */
+type wordSource interface {
+	/* Returns a word chosen by n, the same for a given n while the list is */
+	/* unchanged, or errNoWords when the list is empty */
+	Nth(n uint64) (string, error)
+	/* Returns the whole dictionary, for modes which reason over every word */
+	Words() []string
+	Reload() (int, error)
+}
+
+/* Randomness behind word choice, replaceable with a seeded source for reproducible games */
+var (
+	wordRandMux sync.Mutex
+	wordRand    = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+/* Replaces the randomness behind word choice, making the sequence of words reproducible */
+func seedWords(src rand.Source) {
+	wordRandMux.Lock()
+	wordRand = rand.New(src)
+	wordRandMux.Unlock()
+}
+
+/* Chooses the next secret word */
+func randomWord() (string, error) {
+	wordRandMux.Lock()
+	n := wordRand.Uint64()
+	wordRandMux.Unlock()
+
+	return words.Nth(n)
+}
+
+/* Chooses the secret word for a seed, the same for a given seed while the list is unchanged */
+func seededWord(seed int64) (string, error) {
+	return words.Nth(rand.New(rand.NewSource(seed)).Uint64())
+}
+
+/* Word source using babble's system dictionary, filtered to usable words */
+type babbleSource struct {
+	mux   sync.RWMutex
+	words []string
+}
+
+/* Reads the system dictionary, failing if it is missing or holds no usable words */
+func newBabbleSource() (*babbleSource, error) {
+	bs := &babbleSource{}
+	if _, err := bs.Reload(); err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+func (bs *babbleSource) Nth(n uint64) (string, error) {
+	bs.mux.RLock()
+	defer bs.mux.RUnlock()
+
+	if len(bs.words) == 0 {
+		return "", errNoWords
+	}
+	return bs.words[n%uint64(len(bs.words))], nil
+}
+
+func (bs *babbleSource) Words() []string {
+	bs.mux.RLock()
+	defer bs.mux.RUnlock()
+
+	return append([]string(nil), bs.words...)
+}
+
+/* Reads babble's dictionary. Babble panics when the system dictionary is missing */
+/* or unreadable, so the panic is caught here and returned as an error instead */
+func babbleWords() (dict []string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("system dictionary unreadable: %v", r)
+		}
+	}()
+
+	return babble.NewBabbler().Words, nil
+}
+
+/* Re-reads the system dictionary, keeping the current list if it is unreadable */
+/* or holds no usable words */
+func (bs *babbleSource) Reload() (int, error) {
+	dict, err := babbleWords()
+	if err != nil {
+		return 0, err
+	}
+
+	var words []string
+	for _, word := range dict {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if isWord(word) {
+			words = append(words, word)
+		}
+	}
+
+	if len(words) == 0 {
+		return 0, errors.New("system dictionary contains no usable words")
+	}
+
+	bs.mux.Lock()
+	bs.words = words
+	bs.mux.Unlock()
+
+	return len(words), nil
+}
+
+/* Reports whether a lowercased dictionary entry is usable as a secret word, */
+/* being made only of the letters a-z which players can guess */
+func isWord(word string) bool {
+	return word != "" && strings.IndexFunc(word, func(r rune) bool { return r < 'a' || r > 'z' }) == -1
+}
+
+/* Word source reading one word per line from a file */
+type fileWordSource struct {
+	mux   sync.RWMutex
+	path  string
+	words []string
+}
+
+func newFileWordSource(path string) (*fileWordSource, error) {
+	fw := &fileWordSource{path: path}
+	if _, err := fw.Reload(); err != nil {
+		return nil, err
+	}
+	return fw, nil
+}
+
+func (fw *fileWordSource) Nth(n uint64) (string, error) {
+	fw.mux.RLock()
+	defer fw.mux.RUnlock()
+
+	if len(fw.words) == 0 {
+		return "", errNoWords
+	}
+	return fw.words[n%uint64(len(fw.words))], nil
+}
+
+func (fw *fileWordSource) Words() []string {
+	fw.mux.RLock()
+	defer fw.mux.RUnlock()
+
+	return append([]string(nil), fw.words...)
+}
+
+/* Re-reads the word file, keeping the current list if the file is unusable */
+func (fw *fileWordSource) Reload() (int, error) {
+	f, err := os.Open(fw.path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if isWord(word) {
+			words = append(words, word)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(words) == 0 {
+		return 0, errors.New("word list " + fw.path + " contains no usable words")
+	}
+
+	fw.mux.Lock()
+	fw.words = words
+	fw.mux.Unlock()
+
+	return len(words), nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	hangmanv1 "github.com/hill399/HangmanGo/hangmanpb/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* Plays the part of a freshly started server seeded with seed, returning the words of its first n games */
+func seededServerWords(t *testing.T, seed int64, n int) []string {
+	t.Helper()
+
+	resetServer(t)
+	seedWords(rand.NewSource(seed))
+
+	srv := &server{}
+	var got []string
+	for i := 0; i < n; i++ {
+		res, err := srv.NewGame(context.Background(), &hangmanv1.NewGameRequest{})
+		if err != nil {
+			t.Fatalf("NewGame: %v", err)
+		}
+		pGame, _ := findGame(int(res.GameId))
+		got = append(got, strings.Join(pGame.playWord, ""))
+	}
+	return got
+}
+
+func TestSeededServersChooseSameWords(t *testing.T) {
+	first := seededServerWords(t, 42, 20)
+	second := seededServerWords(t, 42, 20)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Servers seeded alike chose different words:\n%q\n%q", first, second)
+	}
+}
+
+func TestSeededNewGameChoosesSameWord(t *testing.T) {
+	resetServer(t)
+	srv := &server{}
+	seed := int64(7)
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		res, err := srv.NewGame(context.Background(), &hangmanv1.NewGameRequest{Seed: &seed})
+		if err != nil {
+			t.Fatalf("NewGame: %v", err)
+		}
+		pGame, _ := findGame(int(res.GameId))
+		got = append(got, strings.Join(pGame.playWord, ""))
+	}
+
+	if got[0] != got[1] || got[1] != got[2] {
+		t.Errorf("Games seeded alike got different words %q", got)
+	}
+}
+
+func TestFileWordSourceKeepsOnlyPlayableWords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("Apple\ndon't\nZoë\n\ncafé\n  zebra \nice-cream\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fw, err := newFileWordSource(path)
+	if err != nil {
+		t.Fatalf("newFileWordSource: %v", err)
+	}
+
+	want := []string{"apple", "zebra"}
+	if got := fw.Words(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Words() = %q, want %q", got, want)
+	}
+	for n := uint64(0); n < 10; n++ {
+		if word, err := fw.Nth(n); err != nil || (word != "apple" && word != "zebra") {
+			t.Errorf("Nth(%d) = %q, %v, want a word from %q", n, word, err, want)
+		}
+	}
+}
+
+func TestBabbleSourceChoosesFromPlayableWords(t *testing.T) {
+	if _, err := os.Stat("/usr/share/dict/words"); err != nil {
+		t.Skip("No system dictionary")
+	}
+
+	bs, err := newBabbleSource()
+	if err != nil {
+		t.Fatalf("newBabbleSource: %v", err)
+	}
+	playable := make(map[string]bool)
+	for _, word := range bs.Words() {
+		if !isWord(word) {
+			t.Fatalf("Words() holds unplayable %q", word)
+		}
+		playable[word] = true
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if word, err := bs.Nth(rng.Uint64()); err != nil || !playable[word] {
+			t.Fatalf("Nth chose %q, %v, which is not in Words()", word, err)
+		}
+	}
+}
+
+func TestEmptyWordSourceRefusesNewGames(t *testing.T) {
+	resetServer(t)
+	words = &babbleSource{}
+	srv := &server{}
+
+	if _, err := srv.NewGame(context.Background(), &hangmanv1.NewGameRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("NewGame without words returned %v, want FailedPrecondition", err)
+	}
+	if _, err := srv.GetDaily(context.Background(), &hangmanv1.GetDailyRequest{Username: "alice"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetDaily without words returned %v, want FailedPrecondition", err)
+	}
+}